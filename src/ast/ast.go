@@ -2,12 +2,17 @@ package ast
 
 import (
 	"bytes"
+	"devscript/src/token"
 )
 
 // AST is represented in form of nodes
 type Node interface {
 	TokenLiteral() string
 	String() string
+	// position of the first character of the node in the source code
+	Pos() token.Position
+	// position just after the last character of the node
+	End() token.Position
 }
 
 // Program contains a list of statements
@@ -25,6 +30,24 @@ func (p *Program) TokenLiteral() string {
 	}
 }
 
+// Returns the position of the first statement,
+// an invalid position if there are no statements
+func (p *Program) Pos() token.Position {
+	if len(p.Statements) > 0 {
+		return p.Statements[0].Pos()
+	}
+	return token.Position{}
+}
+
+// Returns the end of the last statement,
+// an invalid position if there are no statements
+func (p *Program) End() token.Position {
+	if len(p.Statements) > 0 {
+		return p.Statements[len(p.Statements)-1].End()
+	}
+	return token.Position{}
+}
+
 // String reprentation of the program
 func (p *Program) String() string {
 	var out bytes.Buffer
@@ -35,3 +58,34 @@ func (p *Program) String() string {
 
 	return out.String()
 }
+
+// returns the start of a child node, the fallback if the child is missing
+//
+//	x + y	// the infix expression starts at x
+func startOf(node Node, fallback token.Position) token.Position {
+	if node == nil || !node.Pos().IsValid() {
+		return fallback
+	}
+	return node.Pos()
+}
+
+// returns the end of a child node, the fallback if the child is missing
+//
+//	x + y	// the infix expression ends after y
+func endOf(node Node, fallback token.Position) token.Position {
+	if node == nil || !node.End().IsValid() {
+		return fallback
+	}
+	return node.End()
+}
+
+// returns the end of the closing bracket of a node,
+// the fallback if the node was not closed
+//
+//	f(x)	// the call expression ends after )
+func closedAt(closing token.Token, fallback token.Position) token.Position {
+	if !closing.End.IsValid() {
+		return fallback
+	}
+	return closing.End
+}
@@ -28,6 +28,12 @@ func (i *Identifier) expressionNode() {}
 func (i *Identifier) TokenLiteral() string {
 	return i.Token.Literal
 }
+func (i *Identifier) Pos() token.Position {
+	return i.Token.Pos
+}
+func (i *Identifier) End() token.Position {
+	return i.Token.End
+}
 func (i *Identifier) String() string {
 	return i.Value
 }
@@ -49,11 +55,54 @@ func (il *IntegerLiteral) TokenLiteral() string {
 	return il.Token.Literal
 }
 
+// returns the position of the integer literal
+func (il *IntegerLiteral) Pos() token.Position {
+	return il.Token.Pos
+}
+
+// returns the position just after the integer literal
+func (il *IntegerLiteral) End() token.Position {
+	return il.Token.End
+}
+
 // string representation of the integer literal
 func (il *IntegerLiteral) String() string {
 	return il.Token.Literal
 }
 
+// FloatLiteral is a node that represents a floating-point value
+//
+//	3.14;
+//	1e-9;
+type FloatLiteral struct {
+	// token.FLOAT token
+	Token token.Token
+	// value of the float
+	Value float64
+}
+
+func (fl *FloatLiteral) expressionNode() {}
+
+// returns the token literal of the float literal
+func (fl *FloatLiteral) TokenLiteral() string {
+	return fl.Token.Literal
+}
+
+// returns the position of the float literal
+func (fl *FloatLiteral) Pos() token.Position {
+	return fl.Token.Pos
+}
+
+// returns the position just after the float literal
+func (fl *FloatLiteral) End() token.Position {
+	return fl.Token.End
+}
+
+// string representation of the float literal
+func (fl *FloatLiteral) String() string {
+	return fl.Token.Literal
+}
+
 // StringLiteral is a node that represents a string value
 //
 //	"foobar";
@@ -68,10 +117,55 @@ func (stringLiteral *StringLiteral) expressionNode() {}
 func (stringLiteral *StringLiteral) TokenLiteral() string {
 	return stringLiteral.Token.Literal
 }
+func (stringLiteral *StringLiteral) Pos() token.Position {
+	return stringLiteral.Token.Pos
+}
+func (stringLiteral *StringLiteral) End() token.Position {
+	return stringLiteral.Token.End
+}
 func (stringLiteral *StringLiteral) String() string {
 	return stringLiteral.Token.Literal
 }
 
+// InterpolatedString is a node that represents a string with embedded expressions
+//
+//	"Hello ${name}, you have ${count + 1} items";
+type InterpolatedString struct {
+	// token.STRING_HEAD token
+	Token token.Token
+	// the text of the string as *StringLiteral, alternating with the embedded expressions
+	Parts []Expression
+}
+
+func (is *InterpolatedString) expressionNode() {}
+func (is *InterpolatedString) TokenLiteral() string {
+	return is.Token.Literal
+}
+func (is *InterpolatedString) Pos() token.Position {
+	return is.Token.Pos
+}
+func (is *InterpolatedString) End() token.Position {
+	if len(is.Parts) == 0 {
+		return is.Token.End
+	}
+	return is.Parts[len(is.Parts)-1].End()
+}
+func (is *InterpolatedString) String() string {
+	var out bytes.Buffer
+
+	out.WriteString("\"")
+	for _, part := range is.Parts {
+		if text, ok := part.(*StringLiteral); ok {
+			out.WriteString(text.Value)
+		} else {
+			out.WriteString("${" + part.String() + "}")
+		}
+	}
+	out.WriteString("\"")
+
+	return out.String()
+}
+
 // Prefix Expression is a node that represents a prefix expression
 //
 //	-5, !true
@@ -90,6 +184,12 @@ func (prefixExp *PrefixExpression) expressionNode() {}
 func (prefixExp *PrefixExpression) TokenLiteral() string {
 	return prefixExp.Token.Literal
 }
+func (prefixExp *PrefixExpression) Pos() token.Position {
+	return prefixExp.Token.Pos
+}
+func (prefixExp *PrefixExpression) End() token.Position {
+	return endOf(prefixExp.Right, prefixExp.Token.End)
+}
 func (prefixExp *PrefixExpression) String() string {
 	var out bytes.Buffer
 
@@ -120,6 +220,12 @@ func (infixExp *InfixExpression) expressionNode() {}
 func (infixExp *InfixExpression) TokenLiteral() string {
 	return infixExp.Token.Literal
 }
+func (infixExp *InfixExpression) Pos() token.Position {
+	return startOf(infixExp.Left, infixExp.Token.Pos)
+}
+func (infixExp *InfixExpression) End() token.Position {
+	return endOf(infixExp.Right, infixExp.Token.End)
+}
 func (infixExp *InfixExpression) String() string {
 	var out bytes.Buffer
 
@@ -144,10 +250,62 @@ func (b *Boolean) expressionNode() {}
 func (b *Boolean) TokenLiteral() string {
 	return b.Token.Literal
 }
+func (b *Boolean) Pos() token.Position {
+	return b.Token.Pos
+}
+func (b *Boolean) End() token.Position {
+	return b.Token.End
+}
 func (b *Boolean) String() string {
 	return b.Token.Literal
 }
 
+// Null is a node that represents the null literal
+//
+//	null
+type Null struct {
+	Token token.Token
+}
+
+func (n *Null) expressionNode() {}
+func (n *Null) TokenLiteral() string {
+	return n.Token.Literal
+}
+func (n *Null) Pos() token.Position {
+	return n.Token.Pos
+}
+func (n *Null) End() token.Position {
+	return n.Token.End
+}
+func (n *Null) String() string {
+	return n.Token.Literal
+}
+
+// ConditionalExpression is a node that represents a ternary conditional expression
+//
+//	x > 0 ? "positive" : "negative"
+type ConditionalExpression struct {
+	// token.QUESTION token
+	Token       token.Token
+	Condition   Expression
+	Consequence Expression
+	Alternative Expression
+}
+
+func (ce *ConditionalExpression) expressionNode() {}
+func (ce *ConditionalExpression) TokenLiteral() string {
+	return ce.Token.Literal
+}
+func (ce *ConditionalExpression) Pos() token.Position {
+	return startOf(ce.Condition, ce.Token.Pos)
+}
+func (ce *ConditionalExpression) End() token.Position {
+	return endOf(ce.Alternative, ce.Token.End)
+}
+func (ce *ConditionalExpression) String() string {
+	return "(" + ce.Condition.String() + " ? " + ce.Consequence.String() + " : " + ce.Alternative.String() + ")"
+}
+
 // IfExpression is a node that represents an if expression
 //
 //	if (x < y) { x } else { y }
@@ -162,6 +320,18 @@ func (ie *IfExpression) expressionNode() {}
 func (ie *IfExpression) TokenLiteral() string {
 	return ie.Token.Literal
 }
+func (ie *IfExpression) Pos() token.Position {
+	return ie.Token.Pos
+}
+func (ie *IfExpression) End() token.Position {
+	if ie.Alternative != nil {
+		return ie.Alternative.End()
+	}
+	if ie.Consequence != nil {
+		return ie.Consequence.End()
+	}
+	return ie.Token.End
+}
 func (ie *IfExpression) String() string {
 	var out bytes.Buffer
 
@@ -190,6 +360,15 @@ func (fl *FunctionLiteral) expressionNode() {}
 func (fl *FunctionLiteral) TokenLiteral() string {
 	return fl.Token.Literal
 }
+func (fl *FunctionLiteral) Pos() token.Position {
+	return fl.Token.Pos
+}
+func (fl *FunctionLiteral) End() token.Position {
+	if fl.Body != nil {
+		return fl.Body.End()
+	}
+	return fl.Token.End
+}
 func (fl *FunctionLiteral) String() string {
 	var out bytes.Buffer
 
@@ -219,6 +398,15 @@ func (functionExpression *FunctionExpression) expressionNode() {}
 func (functionExpression *FunctionExpression) TokenLiteral() string {
 	return functionExpression.Token.Literal
 }
+func (functionExpression *FunctionExpression) Pos() token.Position {
+	return functionExpression.Token.Pos
+}
+func (functionExpression *FunctionExpression) End() token.Position {
+	if functionExpression.Body != nil {
+		return functionExpression.Body.End()
+	}
+	return functionExpression.Token.End
+}
 func (functionExpression *FunctionExpression) String() string {
 	var out bytes.Buffer
 
@@ -242,15 +430,24 @@ func (functionExpression *FunctionExpression) String() string {
 //
 //	add(1, 2 * 3, 4 + 5);
 type CallExpression struct {
+	// token.LPAREN token
 	Token     token.Token
 	Function  Expression
 	Arguments []Expression
+	// the closing token.RPAREN token
+	RParen token.Token
 }
 
 func (ce *CallExpression) expressionNode() {}
 func (ce *CallExpression) TokenLiteral() string {
 	return ce.Token.Literal
 }
+func (ce *CallExpression) Pos() token.Position {
+	return startOf(ce.Function, ce.Token.Pos)
+}
+func (ce *CallExpression) End() token.Position {
+	return closedAt(ce.RParen, ce.Token.End)
+}
 func (ce *CallExpression) String() string {
 	var out bytes.Buffer
 
@@ -267,25 +464,227 @@ func (ce *CallExpression) String() string {
 	return out.String()
 }
 
-// AssignmentExpression is a node that represents an assignment expression
+// AssignmentExpression is a node that represents an assignment expression,
+// the target is an identifier, an index expression or a member expression
 //
 //	x = 5;
+//	arr[0] += 1;
+//	obj.count *= 2;
 type AssignmentExpression struct {
+	// token.ASSIGN token or a compound assignment token like token.PLUS_ASSIGN
 	Token token.Token
-	Name  *Identifier
-	Value Expression
+	// =, +=, -=, *=, /= or %=
+	Operator string
+	Target   Expression
+	Value    Expression
 }
 
 func (ae *AssignmentExpression) expressionNode() {}
 func (ae *AssignmentExpression) TokenLiteral() string {
 	return ae.Token.Literal
 }
+func (ae *AssignmentExpression) Pos() token.Position {
+	return startOf(ae.Target, ae.Token.Pos)
+}
+func (ae *AssignmentExpression) End() token.Position {
+	return endOf(ae.Value, ae.Token.End)
+}
 func (ae *AssignmentExpression) String() string {
 	var out bytes.Buffer
 
-	out.WriteString(ae.Name.String())
-	out.WriteString(" = ")
+	out.WriteString(ae.Target.String())
+	out.WriteString(" " + ae.Operator + " ")
 	out.WriteString(ae.Value.String())
 
 	return out.String()
 }
+
+// UpdateExpression is a node that represents an increment or a decrement,
+// the target is an identifier, an index expression or a member expression
+//
+//	++x;	// prefix, evaluates to the new value
+//	x--;	// postfix, evaluates to the old value
+type UpdateExpression struct {
+	// token.INCREMENT or token.DECREMENT token
+	Token token.Token
+	// ++ or --
+	Operator string
+	Target   Expression
+	// true if the operator is written before the target
+	Prefix bool
+}
+
+func (ue *UpdateExpression) expressionNode() {}
+func (ue *UpdateExpression) TokenLiteral() string {
+	return ue.Token.Literal
+}
+func (ue *UpdateExpression) Pos() token.Position {
+	if ue.Prefix {
+		return ue.Token.Pos
+	}
+	return ue.Target.Pos()
+}
+func (ue *UpdateExpression) End() token.Position {
+	if ue.Prefix {
+		return ue.Target.End()
+	}
+	return ue.Token.End
+}
+func (ue *UpdateExpression) String() string {
+	if ue.Prefix {
+		return "(" + ue.Operator + ue.Target.String() + ")"
+	}
+	return "(" + ue.Target.String() + ue.Operator + ")"
+}
+
+// ArrayLiteral is a node that represents an array literal
+//
+//	[1, 2 * 2, "three"];
+type ArrayLiteral struct {
+	// token.LBRACKET token
+	Token    token.Token
+	Elements []Expression
+	// the closing token.RBRACKET token
+	RBracket token.Token
+}
+
+func (al *ArrayLiteral) expressionNode() {}
+func (al *ArrayLiteral) TokenLiteral() string {
+	return al.Token.Literal
+}
+func (al *ArrayLiteral) Pos() token.Position {
+	return al.Token.Pos
+}
+func (al *ArrayLiteral) End() token.Position {
+	return closedAt(al.RBracket, al.Token.End)
+}
+func (al *ArrayLiteral) String() string {
+	var out bytes.Buffer
+
+	elements := []string{}
+	for _, el := range al.Elements {
+		elements = append(elements, el.String())
+	}
+
+	out.WriteString("[")
+	out.WriteString(strings.Join(elements, ", "))
+	out.WriteString("]")
+
+	return out.String()
+}
+
+// IndexExpression is a node that represents an index expression
+//
+//	myArray[1 + 1];
+type IndexExpression struct {
+	// token.LBRACKET token
+	Token token.Token
+	// expression being indexed
+	Left Expression
+	// expression inside the brackets
+	Index Expression
+	// the closing token.RBRACKET token
+	RBracket token.Token
+}
+
+func (ie *IndexExpression) expressionNode() {}
+func (ie *IndexExpression) TokenLiteral() string {
+	return ie.Token.Literal
+}
+func (ie *IndexExpression) Pos() token.Position {
+	return startOf(ie.Left, ie.Token.Pos)
+}
+func (ie *IndexExpression) End() token.Position {
+	return closedAt(ie.RBracket, ie.Token.End)
+}
+func (ie *IndexExpression) String() string {
+	var out bytes.Buffer
+
+	out.WriteString("(")
+	out.WriteString(ie.Left.String())
+	out.WriteString("[")
+	out.WriteString(ie.Index.String())
+	out.WriteString("])")
+
+	return out.String()
+}
+
+// MemberExpression is a node that represents a property access
+//
+//	error.message;
+type MemberExpression struct {
+	// token.DOT token
+	Token token.Token
+	// expression the property is read from
+	Object Expression
+	// name of the property
+	Property *Identifier
+}
+
+func (me *MemberExpression) expressionNode() {}
+func (me *MemberExpression) TokenLiteral() string {
+	return me.Token.Literal
+}
+func (me *MemberExpression) Pos() token.Position {
+	return startOf(me.Object, me.Token.Pos)
+}
+func (me *MemberExpression) End() token.Position {
+	if me.Property != nil {
+		return me.Property.End()
+	}
+	return me.Token.End
+}
+func (me *MemberExpression) String() string {
+	var out bytes.Buffer
+
+	out.WriteString("(")
+	out.WriteString(me.Object.String())
+	out.WriteString(".")
+	out.WriteString(me.Property.String())
+	out.WriteString(")")
+
+	return out.String()
+}
+
+// HashPair is a single key-value pair of a hash literal
+type HashPair struct {
+	Key   Expression
+	Value Expression
+}
+
+// HashLiteral is a node that represents a hash literal.
+// Pairs are kept in source order.
+//
+//	{"name": "x", 1: true};
+type HashLiteral struct {
+	// token.LBRACE token
+	Token token.Token
+	Pairs []HashPair
+	// the closing token.RBRACE token
+	RBrace token.Token
+}
+
+func (hl *HashLiteral) expressionNode() {}
+func (hl *HashLiteral) TokenLiteral() string {
+	return hl.Token.Literal
+}
+func (hl *HashLiteral) Pos() token.Position {
+	return hl.Token.Pos
+}
+func (hl *HashLiteral) End() token.Position {
+	return closedAt(hl.RBrace, hl.Token.End)
+}
+func (hl *HashLiteral) String() string {
+	var out bytes.Buffer
+
+	pairs := []string{}
+	for _, pair := range hl.Pairs {
+		pairs = append(pairs, pair.Key.String()+": "+pair.Value.String())
+	}
+
+	out.WriteString("{")
+	out.WriteString(strings.Join(pairs, ", "))
+	out.WriteString("}")
+
+	return out.String()
+}
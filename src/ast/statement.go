@@ -3,6 +3,7 @@ package ast
 import (
 	"bytes"
 	"devscript/src/token"
+	"strings"
 )
 
 // Statement is a Node that can be executed
@@ -28,6 +29,12 @@ func (vs *VarStatement) statementNode() {}
 func (vs *VarStatement) TokenLiteral() string {
 	return vs.Token.Literal
 }
+func (vs *VarStatement) Pos() token.Position {
+	return vs.Token.Pos
+}
+func (vs *VarStatement) End() token.Position {
+	return endOf(vs.Value, vs.Token.End)
+}
 func (vs *VarStatement) String() string {
 	var out bytes.Buffer
 
@@ -57,6 +64,12 @@ func (rs *ReturnStatement) statementNode() {}
 func (rs *ReturnStatement) TokenLiteral() string {
 	return rs.Token.Literal
 }
+func (rs *ReturnStatement) Pos() token.Position {
+	return rs.Token.Pos
+}
+func (rs *ReturnStatement) End() token.Position {
+	return endOf(rs.ReturnValue, rs.Token.End)
+}
 func (rs *ReturnStatement) String() string {
 	var out bytes.Buffer
 
@@ -83,6 +96,12 @@ func (es *ExpressionStatement) statementNode() {}
 func (es *ExpressionStatement) TokenLiteral() string {
 	return es.Token.Literal
 }
+func (es *ExpressionStatement) Pos() token.Position {
+	return startOf(es.Expression, es.Token.Pos)
+}
+func (es *ExpressionStatement) End() token.Position {
+	return endOf(es.Expression, es.Token.End)
+}
 func (es *ExpressionStatement) String() string {
 	if es.Expression != nil {
 		return es.Expression.String()
@@ -95,14 +114,23 @@ func (es *ExpressionStatement) String() string {
 //
 //	{ x; y; z; }
 type BlockStatement struct {
+	// token.LBRACE token
 	Token      token.Token
 	Statements []Statement
+	// the closing token.RBRACE token
+	RBrace token.Token
 }
 
 func (bs *BlockStatement) statementNode() {}
 func (bs *BlockStatement) TokenLiteral() string {
 	return bs.Token.Literal
 }
+func (bs *BlockStatement) Pos() token.Position {
+	return bs.Token.Pos
+}
+func (bs *BlockStatement) End() token.Position {
+	return closedAt(bs.RBrace, bs.Token.End)
+}
 func (bs *BlockStatement) String() string {
 	var out bytes.Buffer
 
@@ -112,3 +140,236 @@ func (bs *BlockStatement) String() string {
 
 	return out.String()
 }
+
+// While statement repeats the body as long as the condition is truthy
+//
+//	while (x < 10) { x = x + 1; }
+type WhileStatement struct {
+	Token     token.Token // the token.WHILE token
+	Condition Expression
+	Body      *BlockStatement
+}
+
+func (ws *WhileStatement) statementNode() {}
+func (ws *WhileStatement) TokenLiteral() string {
+	return ws.Token.Literal
+}
+func (ws *WhileStatement) Pos() token.Position {
+	return ws.Token.Pos
+}
+func (ws *WhileStatement) End() token.Position {
+	if ws.Body != nil {
+		return ws.Body.End()
+	}
+	return ws.Token.End
+}
+func (ws *WhileStatement) String() string {
+	var out bytes.Buffer
+
+	out.WriteString("while")
+	out.WriteString(ws.Condition.String())
+	out.WriteString(" ")
+	out.WriteString(ws.Body.String())
+
+	return out.String()
+}
+
+// For statement is a C-style loop.
+// Init, Condition and Post are optional.
+//
+//	for (var i = 0; i < 10; i = i + 1) { println(i); }
+type ForStatement struct {
+	Token     token.Token // the token.FOR token
+	Init      Statement
+	Condition Expression
+	Post      Expression
+	Body      *BlockStatement
+}
+
+func (fs *ForStatement) statementNode() {}
+func (fs *ForStatement) TokenLiteral() string {
+	return fs.Token.Literal
+}
+func (fs *ForStatement) Pos() token.Position {
+	return fs.Token.Pos
+}
+func (fs *ForStatement) End() token.Position {
+	if fs.Body != nil {
+		return fs.Body.End()
+	}
+	return fs.Token.End
+}
+func (fs *ForStatement) String() string {
+	var out bytes.Buffer
+
+	out.WriteString("for (")
+	if fs.Init != nil {
+		out.WriteString(strings.TrimSuffix(fs.Init.String(), ";"))
+	}
+	out.WriteString("; ")
+	if fs.Condition != nil {
+		out.WriteString(fs.Condition.String())
+	}
+	out.WriteString("; ")
+	if fs.Post != nil {
+		out.WriteString(fs.Post.String())
+	}
+	out.WriteString(") ")
+	out.WriteString(fs.Body.String())
+
+	return out.String()
+}
+
+// ForIn statement iterates over a string, array, hash or range.
+// Key is only set when two loop variables are given.
+//
+//	for (x in [1, 2, 3]) { println(x); }
+//	for (k, v in {"a": 1}) { println(k, v); }
+type ForInStatement struct {
+	Token    token.Token // the token.FOR token
+	Key      *Identifier
+	Value    *Identifier
+	Iterable Expression
+	Body     *BlockStatement
+}
+
+func (fis *ForInStatement) statementNode() {}
+func (fis *ForInStatement) TokenLiteral() string {
+	return fis.Token.Literal
+}
+func (fis *ForInStatement) Pos() token.Position {
+	return fis.Token.Pos
+}
+func (fis *ForInStatement) End() token.Position {
+	if fis.Body != nil {
+		return fis.Body.End()
+	}
+	return fis.Token.End
+}
+func (fis *ForInStatement) String() string {
+	var out bytes.Buffer
+
+	out.WriteString("for (")
+	if fis.Key != nil {
+		out.WriteString(fis.Key.String())
+		out.WriteString(", ")
+	}
+	out.WriteString(fis.Value.String())
+	out.WriteString(" in ")
+	out.WriteString(fis.Iterable.String())
+	out.WriteString(") ")
+	out.WriteString(fis.Body.String())
+
+	return out.String()
+}
+
+// Break statement exits the innermost loop
+//
+//	break;
+type BreakStatement struct {
+	Token token.Token // the token.BREAK token
+}
+
+func (bs *BreakStatement) statementNode() {}
+func (bs *BreakStatement) TokenLiteral() string {
+	return bs.Token.Literal
+}
+func (bs *BreakStatement) Pos() token.Position {
+	return bs.Token.Pos
+}
+func (bs *BreakStatement) End() token.Position {
+	return bs.Token.End
+}
+func (bs *BreakStatement) String() string {
+	return bs.TokenLiteral() + ";"
+}
+
+// Continue statement skips to the next iteration of the innermost loop
+//
+//	continue;
+type ContinueStatement struct {
+	Token token.Token // the token.CONTINUE token
+}
+
+func (cs *ContinueStatement) statementNode() {}
+func (cs *ContinueStatement) TokenLiteral() string {
+	return cs.Token.Literal
+}
+func (cs *ContinueStatement) Pos() token.Position {
+	return cs.Token.Pos
+}
+func (cs *ContinueStatement) End() token.Position {
+	return cs.Token.End
+}
+func (cs *ContinueStatement) String() string {
+	return cs.TokenLiteral() + ";"
+}
+
+// Throw statement raises an error that can be caught by a try statement
+//
+//	throw "invalid input";
+type ThrowStatement struct {
+	Token token.Token // the token.THROW token
+	Value Expression
+}
+
+func (ts *ThrowStatement) statementNode() {}
+func (ts *ThrowStatement) TokenLiteral() string {
+	return ts.Token.Literal
+}
+func (ts *ThrowStatement) Pos() token.Position {
+	return ts.Token.Pos
+}
+func (ts *ThrowStatement) End() token.Position {
+	return endOf(ts.Value, ts.Token.End)
+}
+func (ts *ThrowStatement) String() string {
+	return ts.TokenLiteral() + " " + ts.Value.String() + ";"
+}
+
+// Try statement runs the catch block when the try block raises an error,
+// the finally block always runs last.
+// Either the catch or the finally block can be left out.
+//
+//	try { risky(); } catch (e) { println(e.message); } finally { cleanup(); }
+type TryStatement struct {
+	Token   token.Token // the token.TRY token
+	Block   *BlockStatement
+	Param   *Identifier // name the caught error is bound to
+	Catch   *BlockStatement
+	Finally *BlockStatement
+}
+
+func (ts *TryStatement) statementNode() {}
+func (ts *TryStatement) TokenLiteral() string {
+	return ts.Token.Literal
+}
+func (ts *TryStatement) Pos() token.Position {
+	return ts.Token.Pos
+}
+func (ts *TryStatement) End() token.Position {
+	for _, block := range []*BlockStatement{ts.Finally, ts.Catch, ts.Block} {
+		if block != nil {
+			return block.End()
+		}
+	}
+	return ts.Token.End
+}
+func (ts *TryStatement) String() string {
+	var out bytes.Buffer
+
+	out.WriteString("try ")
+	out.WriteString(ts.Block.String())
+	if ts.Catch != nil {
+		out.WriteString(" catch (")
+		out.WriteString(ts.Param.String())
+		out.WriteString(") ")
+		out.WriteString(ts.Catch.String())
+	}
+	if ts.Finally != nil {
+		out.WriteString(" finally ")
+		out.WriteString(ts.Finally.String())
+	}
+
+	return out.String()
+}
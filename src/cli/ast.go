@@ -0,0 +1,70 @@
+package cli
+
+import (
+	"devscript/src/ast"
+	"devscript/src/lexer"
+	"devscript/src/parser"
+	"fmt"
+)
+
+// astCommand prints the statements of a script as parsed,
+// with parentheses showing how operators are grouped
+//
+//	devscript ast -e 'var x = 1 + 2 * 3;'
+//	var x = (1 + (2 * 3));
+var astCommand = &command{
+	name:  "ast",
+	args:  "[flags] file.ds",
+	short: "Print the syntax tree of a program",
+	run:   printAST,
+}
+
+func printAST(cli *CLI, command *command, args []string) int {
+	flags := cli.flagSet(command)
+	code := flags.String("e", "", "print the syntax tree of the `code` instead of a file")
+	types := flags.Bool("types", false, "print the node type of every statement")
+	noColor := flags.Bool("no-color", false, "print errors without colors")
+
+	if ok, exitCode := cli.parseFlags(flags, args); !ok {
+		return exitCode
+	}
+
+	if *code == "" && flags.NArg() != 1 {
+		return cli.usageError(command, "expected one file")
+	}
+
+	src, ok := cli.readSource(command, flags.Arg(0), *code)
+	if !ok {
+		return ExitNoInput
+	}
+
+	p := parser.New(lexer.NewWithFile(src.code, src.file))
+	program := p.ParseProgram()
+
+	if len(p.Errors()) != 0 {
+		newRenderer(cli.Stderr, src.code, *noColor).RenderAll(cli.Stderr, p.Diagnostics())
+		return ExitSyntaxError
+	}
+
+	for _, statement := range program.Statements {
+		if *types {
+			fmt.Fprintf(cli.Stdout, "%s\t%s\n", nodeType(statement), statement.String())
+			continue
+		}
+		fmt.Fprintln(cli.Stdout, statement.String())
+	}
+
+	return ExitOK
+}
+
+// returns the type of the statement,
+// followed by the type of the expression of an expression statement
+//
+//	*ast.VarStatement
+//	*ast.ExpressionStatement -> *ast.CallExpression
+func nodeType(statement ast.Statement) string {
+	if expressionStatement, ok := statement.(*ast.ExpressionStatement); ok {
+		return fmt.Sprintf("%T -> %T", statement, expressionStatement.Expression)
+	}
+	return fmt.Sprintf("%T", statement)
+}
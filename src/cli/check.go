@@ -0,0 +1,55 @@
+package cli
+
+import (
+	"devscript/src/lexer"
+	"devscript/src/parser"
+)
+
+// checkCommand reports the syntax errors of scripts without running them
+//
+//	devscript check main.ds lib.ds
+var checkCommand = &command{
+	name:  "check",
+	args:  "[flags] file.ds...",
+	short: "Report syntax errors without running the program",
+	run:   checkScripts,
+}
+
+func checkScripts(cli *CLI, command *command, args []string) int {
+	flags := cli.flagSet(command)
+	code := flags.String("e", "", "check the `code` instead of files")
+	noColor := flags.Bool("no-color", false, "print errors without colors")
+
+	if ok, exitCode := cli.parseFlags(flags, args); !ok {
+		return exitCode
+	}
+
+	paths := flags.Args()
+	if *code != "" {
+		paths = []string{""}
+	}
+	if len(paths) == 0 {
+		return cli.usageError(command, "no files to check")
+	}
+
+	exitCode := ExitOK
+	for _, path := range paths {
+		src, ok := cli.readSource(command, path, *code)
+		if !ok {
+			exitCode = ExitNoInput
+			continue
+		}
+
+		p := parser.New(lexer.NewWithFile(src.code, src.file))
+		p.ParseProgram()
+
+		if len(p.Errors()) != 0 {
+			newRenderer(cli.Stderr, src.code, *noColor).RenderAll(cli.Stderr, p.Diagnostics())
+			if exitCode == ExitOK {
+				exitCode = ExitSyntaxError
+			}
+		}
+	}
+
+	return exitCode
+}
@@ -0,0 +1,262 @@
+package cli
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Version of DevScript
+const Version = "0.0.1"
+
+// Exit codes, following sysexits.h
+const (
+	ExitOK = 0
+	// some of the scripts run by devscript test failed
+	ExitTestsFailed = 1
+	// wrong command line usage (EX_USAGE)
+	ExitUsage = 64
+	// the script has syntax errors (EX_DATAERR)
+	ExitSyntaxError = 65
+	// the script could not be read (EX_NOINPUT)
+	ExitNoInput = 66
+	// the script raised an uncaught runtime error (EX_SOFTWARE)
+	ExitRuntimeError = 70
+)
+
+// CLI holds the streams the commands read from and write to
+type CLI struct {
+	Stdin  io.Reader
+	Stdout io.Writer
+	Stderr io.Writer
+}
+
+// command is a subcommand of the devscript executable
+//
+//	devscript <name> [flags] <args>
+type command struct {
+	name string
+	// arguments shown in the usage line
+	args string
+	// one line description shown in the command list
+	short string
+	// runs the command with the arguments after its name, returns the exit code
+	run func(cli *CLI, command *command, args []string) int
+}
+
+// runs the command with the arguments after its name, returns the exit code
+func (command *command) execute(cli *CLI, args []string) int {
+	return command.run(cli, command, args)
+}
+
+// List of commands, in the order they are listed by help
+var commands []*command
+
+func init() {
+	commands = []*command{
+		runCommand,
+		replCommand,
+		checkCommand,
+		fmtCommand,
+		testCommand,
+		tokensCommand,
+		astCommand,
+		helpCommand,
+		versionCommand,
+	}
+}
+
+// Main runs the command line with the arguments after the program name
+// and returns the exit code of the process
+//
+//	devscript			// starts the REPL
+//	devscript file.ds		// same as devscript run file.ds
+//	devscript run file.ds -- a b	// runs file.ds with the arguments a and b
+func Main(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	cli := &CLI{Stdin: stdin, Stdout: stdout, Stderr: stderr}
+
+	if len(args) == 0 {
+		return replCommand.execute(cli, args)
+	}
+
+	switch name := args[0]; {
+	case name == "--help" || name == "-h":
+		return helpCommand.execute(cli, args[1:])
+	case name == "--version" || name == "-v":
+		return versionCommand.execute(cli, args[1:])
+	case filepath.Ext(name) == ".ds":
+		return runCommand.execute(cli, args)
+	}
+
+	command := lookupCommand(args[0])
+	if command == nil {
+		fmt.Fprintf(stderr, "devscript: unknown command %q\n", args[0])
+		fmt.Fprintln(stderr, "Run 'devscript help' for usage.")
+		return ExitUsage
+	}
+
+	return command.execute(cli, args[1:])
+}
+
+// returns the command with the name, nil if there is none
+func lookupCommand(name string) *command {
+	for _, command := range commands {
+		if command.name == name {
+			return command
+		}
+	}
+	return nil
+}
+
+// returns the flag set of the command, printing the usage of the command for -h
+func (cli *CLI) flagSet(command *command) *flag.FlagSet {
+	flags := flag.NewFlagSet(command.name, flag.ContinueOnError)
+	flags.SetOutput(cli.Stderr)
+	flags.Usage = func() {
+		cli.printCommandUsage(flags.Output(), command, flags)
+	}
+	return flags
+}
+
+// parses the flags of the command.
+// Returns false and the exit code if the command should not run
+//
+//	devscript run -h	// false, ExitOK
+//	devscript run -x	// false, ExitUsage
+func (cli *CLI) parseFlags(flags *flag.FlagSet, args []string) (bool, int) {
+	err := flags.Parse(args)
+	if errors.Is(err, flag.ErrHelp) {
+		return false, ExitOK
+	}
+	if err != nil {
+		return false, ExitUsage
+	}
+	return true, ExitOK
+}
+
+// writes the usage line, the description and the flags of the command
+func (cli *CLI) printCommandUsage(out io.Writer, command *command, flags *flag.FlagSet) {
+	fmt.Fprintf(out, "usage: devscript %s %s\n\n", command.name, command.args)
+	fmt.Fprintf(out, "%s.\n", command.short)
+
+	hasFlags := false
+	flags.VisitAll(func(*flag.Flag) { hasFlags = true })
+	if hasFlags {
+		fmt.Fprintln(out, "\nFlags:")
+		flags.PrintDefaults()
+	}
+}
+
+// reports a wrong usage of the command
+func (cli *CLI) usageError(command *command, format string, a ...interface{}) int {
+	fmt.Fprintf(cli.Stderr, "devscript %s: %s\n", command.name, fmt.Sprintf(format, a...))
+	fmt.Fprintf(cli.Stderr, "Run 'devscript help %s' for usage.\n", command.name)
+	return ExitUsage
+}
+
+// Source code given to a command, read from a file or from the -e flag
+type source struct {
+	// name shown in positions of errors
+	file string
+	code string
+}
+
+// reads the source code of a script, code passed with -e wins over the path.
+// Returns false and reports the error if the script can't be read.
+func (cli *CLI) readSource(command *command, path string, code string) (*source, bool) {
+	if code != "" {
+		return &source{file: "<eval>", code: code}, true
+	}
+
+	if filepath.Ext(path) != ".ds" {
+		fmt.Fprintf(cli.Stderr, "devscript %s: %s: expected a .ds (DevScript) file\n", command.name, path)
+		return nil, false
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		fmt.Fprintf(cli.Stderr, "devscript %s: %s\n", command.name, errorMessage(err))
+		return nil, false
+	}
+
+	return &source{file: path, code: string(content)}, true
+}
+
+// returns the message of a file error without the Go operation name
+//
+//	open main.ds: no such file or directory	// main.ds: no such file or directory
+func errorMessage(err error) string {
+	var pathErr *os.PathError
+	if errors.As(err, &pathErr) {
+		return pathErr.Path + ": " + pathErr.Err.Error()
+	}
+	return err.Error()
+}
+
+// splits the arguments after the flags of a command into
+// the positional arguments and the arguments following "--"
+//
+//	file.ds -- a b	// [file.ds], [a b]
+func splitArgs(args []string) ([]string, []string) {
+	for i, arg := range args {
+		if arg == "--" {
+			return args[:i], args[i+1:]
+		}
+	}
+	return args, nil
+}
+
+// helpCommand prints the list of commands or the usage of a command
+var helpCommand = &command{
+	name:  "help",
+	args:  "[command]",
+	short: "Print the list of commands, or the usage of a command",
+	run: func(cli *CLI, _ *command, args []string) int {
+		if len(args) > 0 {
+			command := lookupCommand(args[0])
+			if command == nil {
+				fmt.Fprintf(cli.Stderr, "devscript help: unknown command %q\n", args[0])
+				return ExitUsage
+			}
+			// the usage is written to stdout, as it was asked for
+			return command.execute(&CLI{Stdin: cli.Stdin, Stdout: cli.Stdout, Stderr: cli.Stdout}, []string{"-h"})
+		}
+
+		fmt.Fprintln(cli.Stdout, "DevScript is a tool for running DevScript programs.")
+		fmt.Fprintln(cli.Stdout)
+		fmt.Fprintln(cli.Stdout, "Usage:")
+		fmt.Fprintln(cli.Stdout, "  devscript <command> [flags] [arguments]")
+		fmt.Fprintln(cli.Stdout, "  devscript file.ds [-- arguments]\t(same as devscript run)")
+		fmt.Fprintln(cli.Stdout)
+		fmt.Fprintln(cli.Stdout, "Commands:")
+
+		width := 0
+		for _, command := range commands {
+			if len(command.name) > width {
+				width = len(command.name)
+			}
+		}
+		for _, command := range commands {
+			fmt.Fprintf(cli.Stdout, "  %s%s  %s\n", command.name, strings.Repeat(" ", width-len(command.name)), command.short)
+		}
+
+		fmt.Fprintln(cli.Stdout)
+		fmt.Fprintln(cli.Stdout, "Run 'devscript help <command>' for the usage of a command.")
+		return ExitOK
+	},
+}
+
+// versionCommand prints the version of DevScript
+var versionCommand = &command{
+	name:  "version",
+	args:  "",
+	short: "Print the version of DevScript",
+	run: func(cli *CLI, _ *command, _ []string) int {
+		fmt.Fprintf(cli.Stdout, "DevScript version %s\n", Version)
+		return ExitOK
+	},
+}
@@ -0,0 +1,318 @@
+package cli
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// runs the command line and returns the exit code, stdout and stderr
+func runMain(args ...string) (int, string, string) {
+	var stdout, stderr bytes.Buffer
+	code := Main(args, strings.NewReader(""), &stdout, &stderr)
+	return code, stdout.String(), stderr.String()
+}
+
+// writes a script into a temporary directory and returns its path
+func writeScript(t *testing.T, name string, content string) string {
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatalf("could not write %s: %v", path, err)
+	}
+	return path
+}
+
+func TestExitCodes(t *testing.T) {
+	valid := writeScript(t, "valid.ds", "var x = 1;")
+	invalid := writeScript(t, "invalid.ds", "var x = ;")
+
+	tests := []struct {
+		args     []string
+		expected int
+	}{
+		{[]string{"run", valid}, ExitOK},
+		{[]string{valid}, ExitOK},
+		{[]string{"run", invalid}, ExitSyntaxError},
+		{[]string{"run", "-e", "1 / 0;"}, ExitRuntimeError},
+		{[]string{"run", "-e", "exit(3);"}, 3},
+		{[]string{"run", "-e", "exit(255);"}, 255},
+		// 256 would be cut down to 0 by the operating system
+		{[]string{"run", "-e", "exit(256);"}, ExitRuntimeError},
+		{[]string{"run", "missing.ds"}, ExitNoInput},
+		{[]string{"run", "script.txt"}, ExitNoInput},
+		{[]string{"run"}, ExitUsage},
+		{[]string{"run", "-unknown", valid}, ExitUsage},
+		{[]string{"run", "-h"}, ExitOK},
+		{[]string{"check", valid}, ExitOK},
+		{[]string{"check", valid, invalid}, ExitSyntaxError},
+		{[]string{"check"}, ExitUsage},
+		{[]string{"fmt"}, ExitUsage},
+		{[]string{"fmt", "-w", "-e", "x;"}, ExitUsage},
+		{[]string{"fmt", valid, invalid}, ExitSyntaxError},
+		{[]string{"test", valid}, ExitOK},
+		{[]string{"test", invalid}, ExitTestsFailed},
+		{[]string{"ast", invalid}, ExitSyntaxError},
+		{[]string{"tokens", valid, invalid}, ExitUsage},
+		{[]string{"unknown"}, ExitUsage},
+		{[]string{"help", "unknown"}, ExitUsage},
+		{[]string{"--version"}, ExitOK},
+	}
+
+	for _, tt := range tests {
+		code, _, stderr := runMain(tt.args...)
+		if code != tt.expected {
+			t.Errorf("devscript %s: wrong exit code. expected=%d, got=%d (stderr: %q)",
+				strings.Join(tt.args, " "), tt.expected, code, stderr)
+		}
+	}
+}
+
+func TestRunReportsErrors(t *testing.T) {
+	path := writeScript(t, "main.ds", "var f = func() {\n  return 1 / 0;\n};\nf();")
+
+	code, stdout, stderr := runMain("run", "-no-color", path)
+	if code != ExitRuntimeError {
+		t.Fatalf("wrong exit code. expected=%d, got=%d", ExitRuntimeError, code)
+	}
+
+	if stdout != "" {
+		t.Errorf("expected no output on stdout, got=%q", stdout)
+	}
+
+	for _, expected := range []string{"Traceback (most recent call last):", "error[E1000]: division by zero", "2 |   return 1 / 0;", "  |          ^~~~~\n"} {
+		if !strings.Contains(stderr, expected) {
+			t.Errorf("stderr does not contain %q. got=%q", expected, stderr)
+		}
+	}
+}
+
+func TestRunScriptArguments(t *testing.T) {
+	path := writeScript(t, "args.ds", "exit(len(args));")
+
+	tests := []struct {
+		args     []string
+		expected int
+	}{
+		{[]string{"run", path}, 0},
+		{[]string{"run", path, "--", "a", "-b"}, 2},
+		{[]string{"run", path, "a", "b", "c"}, 3},
+		{[]string{path, "--", "a"}, 1},
+		{[]string{"run", "-e", "exit(len(args));", "--", "a"}, 1},
+	}
+
+	for _, tt := range tests {
+		code, _, stderr := runMain(tt.args...)
+		if code != tt.expected {
+			t.Errorf("devscript %s: wrong number of args. expected=%d, got=%d (stderr: %q)",
+				strings.Join(tt.args, " "), tt.expected, code, stderr)
+		}
+	}
+}
+
+func TestRunOutput(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	stdin := strings.NewReader("world\n")
+
+	code := Main([]string{"run", "-e", `println("hello " + input()); eprintln("done");`}, stdin, &stdout, &stderr)
+	if code != ExitOK {
+		t.Fatalf("wrong exit code. expected=%d, got=%d", ExitOK, code)
+	}
+
+	if stdout.String() != "hello world\n" {
+		t.Errorf("wrong stdout. got=%q", stdout.String())
+	}
+
+	if stderr.String() != "done\n" {
+		t.Errorf("wrong stderr. got=%q", stderr.String())
+	}
+}
+
+func TestREPLEcho(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	stdin := strings.NewReader("1 + 2\nnull\n[1][5]\nprintln(\"hi\")\n")
+
+	code := Main([]string{"repl", "-quiet"}, stdin, &stdout, &stderr)
+	if code != ExitOK {
+		t.Fatalf("wrong exit code. expected=%d, got=%d", ExitOK, code)
+	}
+
+	// null values are echoed, the result of println is not
+	expected := ">> 3\n>> null\n>> null\n>> hi\n>> "
+	if stdout.String() != expected {
+		t.Errorf("wrong stdout. expected=%q, got=%q", expected, stdout.String())
+	}
+}
+
+func TestRunMissingFile(t *testing.T) {
+	_, _, stderr := runMain("missing.ds")
+
+	expected := "devscript run: missing.ds: no such file or directory\n"
+	if stderr != expected {
+		t.Errorf("wrong error. expected=%q, got=%q", expected, stderr)
+	}
+}
+
+func TestTokensCommand(t *testing.T) {
+	code, stdout, _ := runMain("tokens", "-e", "var x = 5;")
+	if code != ExitOK {
+		t.Fatalf("wrong exit code. expected=%d, got=%d", ExitOK, code)
+	}
+
+	expected := `1:1   VAR    "var"
+1:5   IDENT  "x"
+1:7   =      "="
+1:9   INT    "5"
+1:10  ;      ";"
+`
+	if stdout != expected {
+		t.Errorf("wrong output.\nexpected:\n%s\ngot:\n%s", expected, stdout)
+	}
+}
+
+func TestASTCommand(t *testing.T) {
+	tests := []struct {
+		args     []string
+		expected string
+	}{
+		{[]string{"ast", "-e", "var x = 1 + 2 * 3; println(x);"}, "var x = (1 + (2 * 3));\nprintln(x)\n"},
+		{[]string{"ast", "-types", "-e", "var x = 1; x;"}, "*ast.VarStatement\tvar x = 1;\n*ast.ExpressionStatement -> *ast.Identifier\tx\n"},
+	}
+
+	for _, tt := range tests {
+		_, stdout, _ := runMain(tt.args...)
+		if stdout != tt.expected {
+			t.Errorf("wrong output.\nexpected:\n%s\ngot:\n%s", tt.expected, stdout)
+		}
+	}
+}
+
+func TestFmtCommand(t *testing.T) {
+	code, stdout, _ := runMain("fmt", "-e", "if(x>1){println( x );}")
+	if code != ExitOK {
+		t.Fatalf("wrong exit code. expected=%d, got=%d", ExitOK, code)
+	}
+	if expected := "if (x > 1) { println(x); }\n"; stdout != expected {
+		t.Errorf("wrong output. expected=%q, got=%q", expected, stdout)
+	}
+
+	formatted := writeScript(t, "formatted.ds", "var x = 1;\n")
+	unformatted := writeScript(t, "unformatted.ds", "var x=1;")
+
+	_, stdout, _ = runMain("fmt", "-l", formatted, unformatted)
+	if stdout != unformatted+"\n" {
+		t.Errorf("wrong files listed. expected=%q, got=%q", unformatted+"\n", stdout)
+	}
+
+	code, stdout, _ = runMain("fmt", "-w", unformatted)
+	if code != ExitOK || stdout != "" {
+		t.Fatalf("fmt -w failed. exit code=%d, stdout=%q", code, stdout)
+	}
+	content, err := os.ReadFile(unformatted)
+	if err != nil {
+		t.Fatalf("could not read %s: %v", unformatted, err)
+	}
+	if string(content) != "var x = 1;\n" {
+		t.Errorf("wrong file content. got=%q", content)
+	}
+
+	// files with syntax errors are left unchanged
+	invalid := writeScript(t, "invalid.ds", "var x=;")
+	if code, _, _ := runMain("fmt", "-w", invalid); code != ExitSyntaxError {
+		t.Errorf("wrong exit code. expected=%d, got=%d", ExitSyntaxError, code)
+	}
+	if content, _ := os.ReadFile(invalid); string(content) != "var x=;" {
+		t.Errorf("file with syntax errors was changed. got=%q", content)
+	}
+}
+
+func TestTestCommand(t *testing.T) {
+	dir := t.TempDir()
+	scripts := map[string]string{
+		"pass_test.ds":       `println("passed");`,
+		"fail_test.ds":       `println("before"); throw "assertion failed";`,
+		"sub/exit_test.ds":   "exit(2);",
+		"helper.ds":          "throw 1;",
+		".hidden/b_test.ds":  "throw 1;",
+		"sub/syntax_test.ds": "var = 1;",
+	}
+	for name, content := range scripts {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	code, stdout, _ := runMain("test", "-no-color", dir)
+	if code != ExitTestsFailed {
+		t.Fatalf("wrong exit code. expected=%d, got=%d (stdout: %q)", ExitTestsFailed, code, stdout)
+	}
+
+	for _, expected := range []string{
+		"FAIL  " + filepath.Join(dir, "fail_test.ds") + "\nbefore\n",
+		"assertion failed",
+		"ok    " + filepath.Join(dir, "pass_test.ds") + "\n",
+		"FAIL  " + filepath.Join(dir, "sub", "exit_test.ds"),
+		"FAIL  " + filepath.Join(dir, "sub", "syntax_test.ds"),
+		"FAIL: 3 of 4 tests failed\n",
+	} {
+		if !strings.Contains(stdout, expected) {
+			t.Errorf("output does not contain %q. got=%q", expected, stdout)
+		}
+	}
+
+	// the output of passing tests is only shown with -v
+	if strings.Contains(stdout, "passed") {
+		t.Errorf("output of a passing test is shown. got=%q", stdout)
+	}
+
+	pass := filepath.Join(dir, "pass_test.ds")
+	code, stdout, _ = runMain("test", "-v", pass)
+	if code != ExitOK {
+		t.Fatalf("wrong exit code. expected=%d, got=%d", ExitOK, code)
+	}
+	if expected := "ok    " + pass + "\npassed\nPASS: all 1 tests passed\n"; stdout != expected {
+		t.Errorf("wrong output. expected=%q, got=%q", expected, stdout)
+	}
+
+	if code, _, _ := runMain("test", filepath.Join(dir, "missing")); code != ExitNoInput {
+		t.Errorf("wrong exit code. expected=%d, got=%d", ExitNoInput, code)
+	}
+}
+
+func TestHelp(t *testing.T) {
+	_, stdout, _ := runMain("help")
+	for _, command := range commands {
+		if !strings.Contains(stdout, "  "+command.name+" ") {
+			t.Errorf("help does not list %s. got=%q", command.name, stdout)
+		}
+	}
+
+	_, stdout, _ = runMain("help", "run")
+	if !strings.HasPrefix(stdout, "usage: devscript run [flags] file.ds [-- arguments]\n") {
+		t.Errorf("wrong usage of run. got=%q", stdout)
+	}
+}
+
+func TestSplitArgs(t *testing.T) {
+	tests := []struct {
+		args       []string
+		positional []string
+		rest       []string
+	}{
+		{[]string{"main.ds"}, []string{"main.ds"}, nil},
+		{[]string{"main.ds", "--", "a", "-b"}, []string{"main.ds"}, []string{"a", "-b"}},
+		{[]string{"main.ds", "--"}, []string{"main.ds"}, []string{}},
+	}
+
+	for _, tt := range tests {
+		positional, rest := splitArgs(tt.args)
+		if strings.Join(positional, " ") != strings.Join(tt.positional, " ") || strings.Join(rest, " ") != strings.Join(tt.rest, " ") {
+			t.Errorf("splitArgs(%q) = %q, %q. expected %q, %q", tt.args, positional, rest, tt.positional, tt.rest)
+		}
+	}
+}
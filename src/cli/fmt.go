@@ -0,0 +1,109 @@
+package cli
+
+import (
+	"devscript/src/formatter"
+	"devscript/src/lexer"
+	"devscript/src/parser"
+	"fmt"
+	"os"
+)
+
+// fmtCommand prints scripts in the standard layout, or rewrites the files with -w
+//
+//	devscript fmt main.ds
+//	devscript fmt -w main.ds lib.ds
+//	devscript fmt -l *.ds	// lists the files that are not formatted
+var fmtCommand = &command{
+	name:  "fmt",
+	args:  "[flags] file.ds...",
+	short: "Format programs in the standard layout",
+	run:   formatScripts,
+}
+
+func formatScripts(cli *CLI, command *command, args []string) int {
+	flags := cli.flagSet(command)
+	code := flags.String("e", "", "format the `code` instead of files")
+	write := flags.Bool("w", false, "write the result to the files instead of printing it")
+	list := flags.Bool("l", false, "list the files that are not formatted instead of printing them")
+	noColor := flags.Bool("no-color", false, "print errors without colors")
+
+	if ok, exitCode := cli.parseFlags(flags, args); !ok {
+		return exitCode
+	}
+
+	paths := flags.Args()
+	if *code != "" {
+		if *write {
+			return cli.usageError(command, "-w can't be used with -e")
+		}
+		paths = []string{""}
+	}
+	if len(paths) == 0 {
+		return cli.usageError(command, "no files to format")
+	}
+
+	exitCode := ExitOK
+	// keeps the first error, like check
+	fail := func(code int) {
+		if exitCode == ExitOK {
+			exitCode = code
+		}
+	}
+
+	for _, path := range paths {
+		src, ok := cli.readSource(command, path, *code)
+		if !ok {
+			fail(ExitNoInput)
+			continue
+		}
+
+		// only programs without syntax errors are formatted
+		p := parser.New(lexer.NewWithFile(src.code, src.file))
+		p.ParseProgram()
+		if len(p.Errors()) != 0 {
+			newRenderer(cli.Stderr, src.code, *noColor).RenderAll(cli.Stderr, p.Diagnostics())
+			fail(ExitSyntaxError)
+			continue
+		}
+
+		formatted, err := formatter.Format(src.code)
+		if err != nil {
+			fmt.Fprintf(cli.Stderr, "devscript %s: %s: %s\n", command.name, src.file, err)
+			fail(ExitRuntimeError)
+			continue
+		}
+
+		changed := formatted != src.code
+		if *list && changed {
+			fmt.Fprintln(cli.Stdout, src.file)
+		}
+
+		if *write {
+			if changed && !cli.writeFile(command, src.file, formatted) {
+				fail(ExitNoInput)
+			}
+			continue
+		}
+
+		if !*list {
+			fmt.Fprint(cli.Stdout, formatted)
+		}
+	}
+
+	return exitCode
+}
+
+// replaces the content of the file, keeping its permissions.
+// Returns false and reports the error if the file can't be written.
+func (cli *CLI) writeFile(command *command, path string, content string) bool {
+	info, err := os.Stat(path)
+	if err == nil {
+		err = os.WriteFile(path, []byte(content), info.Mode().Perm())
+	}
+
+	if err != nil {
+		fmt.Fprintf(cli.Stderr, "devscript %s: %s\n", command.name, errorMessage(err))
+		return false
+	}
+	return true
+}
@@ -0,0 +1,43 @@
+package cli
+
+import (
+	"devscript/src/repl"
+	"fmt"
+	"os/user"
+)
+
+// replCommand starts the interactive prompt
+//
+//	devscript repl
+//	devscript repl -quiet
+var replCommand = &command{
+	name:  "repl",
+	args:  "[flags]",
+	short: "Start the interactive DevScript prompt",
+	run:   startREPL,
+}
+
+func startREPL(cli *CLI, command *command, args []string) int {
+	flags := cli.flagSet(command)
+	quiet := flags.Bool("quiet", false, "do not print the welcome message")
+
+	if ok, exitCode := cli.parseFlags(flags, args); !ok {
+		return exitCode
+	}
+
+	if flags.NArg() > 0 {
+		return cli.usageError(command, "unexpected argument %q", flags.Arg(0))
+	}
+
+	if !*quiet {
+		name := "there"
+		if current, err := user.Current(); err == nil {
+			name = current.Username
+		}
+
+		fmt.Fprintf(cli.Stdout, "Hello %s! This is the DevScript programming language!\n", name)
+		fmt.Fprintf(cli.Stdout, "Feel free to type in commands\n")
+	}
+
+	return repl.Start(cli.Stdin, cli.Stdout)
+}
@@ -0,0 +1,102 @@
+package cli
+
+import (
+	"devscript/src/diagnostics"
+	"devscript/src/eval"
+	"devscript/src/lexer"
+	"devscript/src/object"
+	"devscript/src/parser"
+	"fmt"
+	"io"
+)
+
+// runCommand runs a script
+//
+//	devscript run main.ds
+//	devscript run main.ds -- input.txt -v
+//	devscript run -e 'println(1 + 2)'
+var runCommand = &command{
+	name:  "run",
+	args:  "[flags] file.ds [-- arguments]",
+	short: "Run a DevScript program",
+	run:   runScript,
+}
+
+func runScript(cli *CLI, command *command, args []string) int {
+	flags := cli.flagSet(command)
+	code := flags.String("e", "", "run the `code` instead of a file")
+	noColor := flags.Bool("no-color", false, "print errors without colors")
+
+	if ok, exitCode := cli.parseFlags(flags, args); !ok {
+		return exitCode
+	}
+
+	positional, scriptArgs := splitArgs(flags.Args())
+	if *code == "" && len(positional) == 0 {
+		return cli.usageError(command, "no file to run")
+	}
+
+	// the arguments after the file are passed to the script, with or without "--"
+	path := ""
+	if *code == "" {
+		path, positional = positional[0], positional[1:]
+	}
+	if len(positional) > 0 {
+		scriptArgs = append(positional, scriptArgs...)
+	}
+
+	src, ok := cli.readSource(command, path, *code)
+	if !ok {
+		return ExitNoInput
+	}
+
+	return cli.run(src, scriptArgs, *noColor)
+}
+
+// parses and evaluates the source code,
+// reports syntax errors and uncaught runtime errors on stderr
+func (cli *CLI) run(src *source, args []string, noColor bool) (exitCode int) {
+	lex := lexer.NewWithFile(src.code, src.file)
+	p := parser.New(lex)
+	program := p.ParseProgram()
+
+	// report syntax errors with the source code they point into
+	if len(p.Errors()) != 0 {
+		newRenderer(cli.Stderr, src.code, noColor).RenderAll(cli.Stderr, p.Diagnostics())
+		return ExitSyntaxError
+	}
+
+	// report Go panics inside the interpreter instead of crashing with a stack dump
+	defer func() {
+		if r := recover(); r != nil {
+			fmt.Fprintf(cli.Stderr, "internal error: %v\n", r)
+			exitCode = ExitRuntimeError
+		}
+	}()
+
+	ctx := object.NewContext(cli.Stdin, cli.Stdout, cli.Stderr)
+	env := object.NewEnvironmentWithContext(ctx)
+	eval.SetArgs(env, args)
+
+	result := eval.Eval(program, env)
+
+	switch result := result.(type) {
+	// report an uncaught runtime error
+	case *object.Error:
+		newRenderer(cli.Stderr, src.code, noColor).RenderTraceback(cli.Stderr, result)
+		return ExitRuntimeError
+	// the script called exit(code)
+	case *object.Exit:
+		return result.Code
+	}
+
+	return ExitOK
+}
+
+// returns the renderer for errors written to out
+func newRenderer(out io.Writer, source string, noColor bool) *diagnostics.Renderer {
+	if noColor {
+		return diagnostics.NewRenderer(source)
+	}
+	return diagnostics.NewRendererFor(out, source)
+}
@@ -0,0 +1,122 @@
+package cli
+
+import (
+	"bytes"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Suffix of the names of test scripts found in directories
+const testSuffix = "_test.ds"
+
+// testCommand runs test scripts, a test fails if its script raises an
+// uncaught error, has syntax errors or exits with a code other than 0
+//
+//	devscript test			// runs the *_test.ds files in the current directory and below
+//	devscript test tests/ math_test.ds
+//	ok    math_test.ds
+//	FAIL  tests/string_test.ds
+var testCommand = &command{
+	name:  "test",
+	args:  "[flags] [file.ds | directory]...",
+	short: "Run test scripts and report the ones that fail",
+	run:   runTests,
+}
+
+func runTests(cli *CLI, command *command, args []string) int {
+	flags := cli.flagSet(command)
+	verbose := flags.Bool("v", false, "print the output of passing tests too")
+	noColor := flags.Bool("no-color", false, "print errors without colors")
+
+	if ok, exitCode := cli.parseFlags(flags, args); !ok {
+		return exitCode
+	}
+
+	paths := flags.Args()
+	if len(paths) == 0 {
+		paths = []string{"."}
+	}
+
+	files, ok := cli.findTests(command, paths)
+	if !ok {
+		return ExitNoInput
+	}
+	if len(files) == 0 {
+		fmt.Fprintln(cli.Stdout, "no test files")
+		return ExitOK
+	}
+
+	failed := 0
+	for _, file := range files {
+		src, ok := cli.readSource(command, file, "")
+		if !ok {
+			failed++
+			continue
+		}
+
+		// the output of a test is only shown if it fails, or with -v
+		var output bytes.Buffer
+		test := &CLI{Stdin: cli.Stdin, Stdout: &output, Stderr: &output}
+		exitCode := test.run(src, nil, *noColor)
+
+		if exitCode != ExitOK {
+			failed++
+			fmt.Fprintf(cli.Stdout, "FAIL  %s\n", file)
+		} else {
+			fmt.Fprintf(cli.Stdout, "ok    %s\n", file)
+		}
+		if exitCode != ExitOK || *verbose {
+			cli.Stdout.Write(output.Bytes())
+		}
+	}
+
+	if failed > 0 {
+		fmt.Fprintf(cli.Stdout, "FAIL: %d of %d tests failed\n", failed, len(files))
+		return ExitTestsFailed
+	}
+	fmt.Fprintf(cli.Stdout, "PASS: all %d tests passed\n", len(files))
+	return ExitOK
+}
+
+// returns the test scripts to run: the files given,
+// and the *_test.ds files inside the directories given.
+// Returns false and reports the error if a path can't be read.
+func (cli *CLI) findTests(command *command, paths []string) ([]string, bool) {
+	var files []string
+
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			fmt.Fprintf(cli.Stderr, "devscript %s: %s\n", command.name, errorMessage(err))
+			return nil, false
+		}
+
+		if !info.IsDir() {
+			files = append(files, path)
+			continue
+		}
+
+		err = filepath.WalkDir(path, func(file string, entry fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			// skip hidden directories like .git
+			if entry.IsDir() && file != path && strings.HasPrefix(entry.Name(), ".") {
+				return filepath.SkipDir
+			}
+			if !entry.IsDir() && strings.HasSuffix(entry.Name(), testSuffix) {
+				files = append(files, file)
+			}
+			return nil
+		})
+		if err != nil {
+			fmt.Fprintf(cli.Stderr, "devscript %s: %s\n", command.name, errorMessage(err))
+			return nil, false
+		}
+	}
+
+	return files, true
+}
@@ -0,0 +1,48 @@
+package cli
+
+import (
+	"devscript/src/lexer"
+	"devscript/src/token"
+	"fmt"
+	"text/tabwriter"
+)
+
+// tokensCommand prints the tokens of a script, one per line
+//
+//	devscript tokens -e 'var x = 5;'
+//	1:1   VAR     "var"
+//	1:5   IDENT   "x"
+//	...
+var tokensCommand = &command{
+	name:  "tokens",
+	args:  "[flags] file.ds",
+	short: "Print the tokens of a program",
+	run:   printTokens,
+}
+
+func printTokens(cli *CLI, command *command, args []string) int {
+	flags := cli.flagSet(command)
+	code := flags.String("e", "", "print the tokens of the `code` instead of a file")
+
+	if ok, exitCode := cli.parseFlags(flags, args); !ok {
+		return exitCode
+	}
+
+	if *code == "" && flags.NArg() != 1 {
+		return cli.usageError(command, "expected one file")
+	}
+
+	src, ok := cli.readSource(command, flags.Arg(0), *code)
+	if !ok {
+		return ExitNoInput
+	}
+
+	out := tabwriter.NewWriter(cli.Stdout, 0, 0, 2, ' ', 0)
+	lex := lexer.NewWithFile(src.code, src.file)
+	for tok := lex.NextToken(); tok.Type != token.EOF; tok = lex.NextToken() {
+		fmt.Fprintf(out, "%d:%d\t%s\t%q\n", tok.Pos.Line, tok.Pos.Column, tok.Type, tok.Literal)
+	}
+	out.Flush()
+
+	return ExitOK
+}
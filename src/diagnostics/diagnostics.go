@@ -0,0 +1,83 @@
+package diagnostics
+
+import (
+	"devscript/src/object"
+	"devscript/src/token"
+	"fmt"
+)
+
+// Error codes, shown next to the error message
+//
+//	error[E0001]: expected next token to be ), got { instead
+const (
+	// a token other than the expected one was found
+	UnexpectedToken = "E0001"
+	// a number literal could not be converted to a value
+	InvalidNumber = "E0002"
+	// break or continue outside of a loop
+	LoopControlOutsideLoop = "E0003"
+	// an unterminated string literal or an invalid escape sequence
+	InvalidString = "E0004"
+
+	// an error raised while evaluating the program
+	RuntimeError = "E1000"
+)
+
+// Span is a range of source code, from Start up to (not including) End
+type Span struct {
+	Start token.Position
+	End   token.Position
+}
+
+// TokenSpan returns the span of the token
+func TokenSpan(tok token.Token) Span {
+	return Span{Start: tok.Pos, End: tok.End}
+}
+
+// Diagnostic is an error found in the source code
+//
+//	error[E0001]: expected next token to be ), got { instead
+//	 --> main.ds:2:7
+//	  |
+//	2 | if (x { x }
+//	  |       ^
+type Diagnostic struct {
+	// error code, one of the constants above
+	Code string
+	// what went wrong
+	Message string
+	// where it went wrong
+	Span Span
+	// optional hint on how to fix the error
+	Help string
+}
+
+// New returns a new Diagnostic with a formatted message
+func New(code string, span Span, format string, a ...interface{}) *Diagnostic {
+	return &Diagnostic{Code: code, Span: span, Message: fmt.Sprintf(format, a...)}
+}
+
+// FromError returns the Diagnostic for a runtime error
+func FromError(err *object.Error) *Diagnostic {
+	return &Diagnostic{
+		Code:    RuntimeError,
+		Message: err.Message,
+		Span:    Span{Start: err.Pos, End: err.End},
+	}
+}
+
+// WithHint sets the help text of the diagnostic and returns it
+func (d *Diagnostic) WithHint(help string) *Diagnostic {
+	d.Help = help
+	return d
+}
+
+// Error returns the diagnostic on a single line
+//
+//	main.ds:2:7: expected next token to be ), got { instead
+func (d *Diagnostic) Error() string {
+	if d.Span.Start.IsValid() {
+		return d.Span.Start.String() + ": " + d.Message
+	}
+	return d.Message
+}
@@ -0,0 +1,133 @@
+package diagnostics
+
+import (
+	"bytes"
+	"devscript/src/object"
+	"devscript/src/token"
+	"strings"
+	"testing"
+)
+
+func span(line, startColumn, endColumn int) Span {
+	return Span{
+		Start: token.Position{File: "main.ds", Line: line, Column: startColumn},
+		End:   token.Position{File: "main.ds", Line: line, Column: endColumn},
+	}
+}
+
+func TestRender(t *testing.T) {
+	tests := []struct {
+		source     string
+		diagnostic *Diagnostic
+		expected   string
+	}{
+		{
+			"var x = 5;\nif (x { x }",
+			New(UnexpectedToken, span(2, 7, 8), "expected next token to be %s, got %s instead", ")", "{"),
+			"error[E0001]: expected next token to be ), got { instead\n" +
+				" --> main.ds:2:7\n" +
+				"  |\n" +
+				"2 | if (x { x }\n" +
+				"  |       ^\n",
+		},
+		{
+			"while (true) { break; } break;",
+			New(LoopControlOutsideLoop, span(1, 25, 30), "break statement outside of loop").
+				WithHint("break can only be used inside a while or for loop"),
+			"error[E0003]: break statement outside of loop\n" +
+				" --> main.ds:1:25\n" +
+				"  |\n" +
+				"1 | while (true) { break; } break;\n" +
+				"  |                         ^~~~~\n" +
+				"  |\n" +
+				"  = help: break can only be used inside a while or for loop\n",
+		},
+		{
+			"\tx + true;",
+			FromError(&object.Error{
+				Message: "type mismatch: INTEGER + BOOLEAN",
+				Pos:     token.Position{Line: 1, Column: 4},
+				End:     token.Position{Line: 1, Column: 5},
+			}),
+			"error[E1000]: type mismatch: INTEGER + BOOLEAN\n" +
+				" --> 1:4\n" +
+				"  |\n" +
+				"1 | \tx + true;\n" +
+				"  | \t  ^\n",
+		},
+	}
+
+	for _, tt := range tests {
+		var out bytes.Buffer
+		NewRenderer(tt.source).Render(&out, tt.diagnostic)
+
+		if out.String() != tt.expected {
+			t.Errorf("wrong rendering.\nexpected:\n%s\ngot:\n%s", tt.expected, out.String())
+		}
+	}
+}
+
+func TestRenderWithoutPosition(t *testing.T) {
+	var out bytes.Buffer
+	NewRenderer("x").Render(&out, FromError(&object.Error{Message: "internal error: boom"}))
+
+	expected := "error[E1000]: internal error: boom\n"
+	if out.String() != expected {
+		t.Errorf("wrong rendering. expected=%q, got=%q", expected, out.String())
+	}
+}
+
+func TestRenderColor(t *testing.T) {
+	var out bytes.Buffer
+	NewColorRenderer("var = 5;").Render(&out, New(UnexpectedToken, span(1, 5, 6), "unexpected ="))
+
+	if !strings.Contains(out.String(), ansiRed+"error[E0001]"+ansiReset) {
+		t.Errorf("expected colored error header, got=%q", out.String())
+	}
+}
+
+func TestDiagnosticError(t *testing.T) {
+	d := New(UnexpectedToken, span(3, 3, 4), "unexpected %s", "}")
+
+	expected := "main.ds:3:3: unexpected }"
+	if d.Error() != expected {
+		t.Errorf("wrong error. expected=%q, got=%q", expected, d.Error())
+	}
+}
+
+func TestRenderTraceback(t *testing.T) {
+	source := "var f = func(n) {\n  if (n == 0) { 1 / n } else { f(n - 1) }\n};\nf(5);"
+	position := func(line, column int) token.Position {
+		return token.Position{File: "main.ds", Line: line, Column: column}
+	}
+
+	err := &object.Error{
+		Message: "division by zero",
+		Pos:     position(2, 19),
+		End:     position(2, 20),
+		Stack:   []object.Frame{{Function: "f", Pos: position(4, 1)}},
+	}
+	for i := 0; i < 5; i++ {
+		err.Stack = append(err.Stack, object.Frame{Function: "f", Pos: position(2, 32)})
+	}
+
+	expected := "Traceback (most recent call last):\n" +
+		"  File \"main.ds\", line 4, column 1, in <main>\n" +
+		"  File \"main.ds\", line 2, column 32, in f\n" +
+		"  File \"main.ds\", line 2, column 32, in f\n" +
+		"  File \"main.ds\", line 2, column 32, in f\n" +
+		"  [Previous line repeated 2 more times]\n" +
+		"  File \"main.ds\", line 2, column 19, in f\n" +
+		"error[E1000]: division by zero\n" +
+		" --> main.ds:2:19\n" +
+		"  |\n" +
+		"2 |   if (n == 0) { 1 / n } else { f(n - 1) }\n" +
+		"  |                   ^\n"
+
+	var out bytes.Buffer
+	NewRenderer(source).RenderTraceback(&out, err)
+
+	if out.String() != expected {
+		t.Errorf("wrong traceback.\nexpected:\n%s\ngot:\n%s", expected, out.String())
+	}
+}
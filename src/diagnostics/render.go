@@ -0,0 +1,175 @@
+package diagnostics
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// ANSI escape codes used by the colored renderer
+const (
+	ansiReset = "\033[0m"
+	ansiBold  = "\033[1m"
+	ansiRed   = "\033[1;31m"
+	ansiBlue  = "\033[1;34m"
+	ansiCyan  = "\033[1;36m"
+)
+
+// Renderer writes diagnostics along with the source code they point into
+//
+//	error[E0001]: expected next token to be ), got { instead
+//	 --> main.ds:2:7
+//	  |
+//	2 | if (x { x }
+//	  |       ^
+//	  |
+//	  = help: ...
+type Renderer struct {
+	// lines of the source code
+	lines []string
+	// write ANSI color codes
+	color bool
+}
+
+// NewRenderer returns a Renderer that writes plain text
+func NewRenderer(source string) *Renderer {
+	return &Renderer{lines: strings.Split(source, "\n")}
+}
+
+// NewColorRenderer returns a Renderer that writes ANSI colored text
+func NewColorRenderer(source string) *Renderer {
+	renderer := NewRenderer(source)
+	renderer.color = true
+	return renderer
+}
+
+// NewRendererFor returns a colored Renderer if out is a terminal
+// and the NO_COLOR environment variable is not set, a plain one otherwise
+func NewRendererFor(out io.Writer, source string) *Renderer {
+	if isTerminal(out) && os.Getenv("NO_COLOR") == "" {
+		return NewColorRenderer(source)
+	}
+	return NewRenderer(source)
+}
+
+// Render writes a single diagnostic
+func (r *Renderer) Render(out io.Writer, d *Diagnostic) {
+	// error[E0001]: message
+	fmt.Fprintf(out, "%s: %s\n", r.paint(ansiRed, "error["+d.Code+"]"), r.paint(ansiBold, d.Message))
+
+	start := d.Span.Start
+	line, ok := r.line(start.Line)
+	if !ok {
+		r.renderHelp(out, d, " ")
+		return
+	}
+
+	gutter := strings.Repeat(" ", len(strconv.Itoa(start.Line)))
+
+	//  --> main.ds:2:7
+	fmt.Fprintf(out, "%s%s %s\n", gutter, r.paint(ansiBlue, "-->"), start)
+	//   |
+	fmt.Fprintf(out, "%s %s\n", gutter, r.paint(ansiBlue, "|"))
+	// 2 | if (x { x }
+	fmt.Fprintf(out, "%s %s\n", r.paint(ansiBlue, strconv.Itoa(start.Line)+" |"), line)
+	//   |       ^
+	fmt.Fprintf(out, "%s %s%s\n", gutter, r.paint(ansiBlue, "|"), r.paint(ansiRed, underline(line, d.Span)))
+
+	r.renderHelp(out, d, gutter)
+}
+
+// RenderAll writes every diagnostic, separated by an empty line
+func (r *Renderer) RenderAll(out io.Writer, diagnostics []*Diagnostic) {
+	for i, d := range diagnostics {
+		if i > 0 {
+			io.WriteString(out, "\n")
+		}
+		r.Render(out, d)
+	}
+}
+
+// writes the help text of the diagnostic, if there is one
+//
+//	|
+//	= help: ...
+func (r *Renderer) renderHelp(out io.Writer, d *Diagnostic, gutter string) {
+	if d.Help == "" {
+		return
+	}
+
+	fmt.Fprintf(out, "%s %s\n", gutter, r.paint(ansiBlue, "|"))
+	fmt.Fprintf(out, "%s %s %s\n", gutter, r.paint(ansiBlue, "="), r.paint(ansiCyan, "help:")+" "+d.Help)
+}
+
+// returns the source line with the given number, lines start at 1
+func (r *Renderer) line(number int) (string, bool) {
+	if number < 1 || number > len(r.lines) {
+		return "", false
+	}
+	return strings.TrimRight(r.lines[number-1], "\r"), true
+}
+
+// wraps the text in the ANSI code when rendering with color
+func (r *Renderer) paint(code string, text string) string {
+	if !r.color {
+		return text
+	}
+	return code + text + ansiReset
+}
+
+// returns the marker placed under the span: a caret under the first
+// character, followed by a tilde under every other character of the span.
+// Spans running past the end of the line are cut at the end of the line.
+//
+//	if (x { x }
+//	      ^
+func underline(line string, span Span) string {
+	runes := []rune(line)
+	start := span.Start.Column - 1
+	if start < 0 {
+		start = 0
+	}
+	if start > len(runes) {
+		start = len(runes)
+	}
+
+	width := 1
+	if span.End.Line == span.Start.Line && span.End.Column > span.Start.Column {
+		width = span.End.Column - span.Start.Column
+	} else if span.End.Line > span.Start.Line {
+		width = len(runes) - start
+	}
+	if width < 1 {
+		width = 1
+	}
+
+	// keep the tabs of the source line, so the marker lines up with the code
+	var padding strings.Builder
+	padding.WriteString(" ")
+	for _, r := range runes[:start] {
+		if r == '\t' {
+			padding.WriteRune('\t')
+		} else {
+			padding.WriteRune(' ')
+		}
+	}
+
+	return padding.String() + "^" + strings.Repeat("~", width-1)
+}
+
+// returns true if out is a terminal
+func isTerminal(out io.Writer) bool {
+	file, ok := out.(*os.File)
+	if !ok {
+		return false
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		return false
+	}
+
+	return info.Mode()&os.ModeCharDevice != 0
+}
@@ -0,0 +1,68 @@
+package diagnostics
+
+import (
+	"devscript/src/object"
+	"fmt"
+	"io"
+)
+
+// Number of identical frames printed before the rest are collapsed,
+// so a runaway recursion does not print thousands of lines
+const maxRepeatedFrames = 3
+
+// RenderTraceback writes the call stack of a runtime error,
+// the most recent call last, followed by the error itself.
+// Errors raised outside of any function are written without a traceback.
+//
+//	Traceback (most recent call last):
+//	  File "main.ds", line 9, column 1, in <main>
+//	  File "main.ds", line 5, column 10, in outer
+//	  File "main.ds", line 2, column 12, in inner
+//	error[E1000]: division by zero
+//	 --> main.ds:2:12
+//	...
+func (r *Renderer) RenderTraceback(out io.Writer, err *object.Error) {
+	if len(err.Stack) > 0 {
+		fmt.Fprintln(out, r.paint(ansiBold, "Traceback (most recent call last):"))
+
+		previous := ""
+		repeated := 0
+		for _, frame := range err.Traceback() {
+			line := frameLine(frame)
+			if line == previous {
+				repeated++
+				if repeated >= maxRepeatedFrames {
+					continue
+				}
+			} else {
+				r.renderRepeated(out, repeated)
+				repeated = 0
+			}
+
+			fmt.Fprintln(out, line)
+			previous = line
+		}
+		r.renderRepeated(out, repeated)
+	}
+
+	r.Render(out, FromError(err))
+}
+
+// writes the number of frames collapsed by RenderTraceback
+func (r *Renderer) renderRepeated(out io.Writer, repeated int) {
+	if repeated < maxRepeatedFrames {
+		return
+	}
+	fmt.Fprintf(out, "  [Previous line repeated %d more times]\n", repeated-maxRepeatedFrames+1)
+}
+
+// returns the traceback line of a frame
+//
+//	File "main.ds", line 5, column 10, in outer
+func frameLine(frame object.Frame) string {
+	file := frame.Pos.File
+	if file == "" {
+		file = "<input>"
+	}
+	return fmt.Sprintf("  File %q, line %d, column %d, in %s", file, frame.Pos.Line, frame.Pos.Column, frame.Function)
+}
@@ -0,0 +1,206 @@
+package eval
+
+import (
+	"devscript/src/ast"
+	"devscript/src/object"
+	"strings"
+)
+
+// A variable, an array element or a hash entry that is assigned to
+type reference struct {
+	// reads the current value, used by compound assignments, increments and decrements
+	get func() object.Object
+	// stores the new value, returns an error if the value cannot be stored
+	set func(value object.Object) *object.Error
+}
+
+// evaluates an assignment expression,
+// a variable is updated in the scope it is declared in.
+//
+// The target is evaluated before the value, a compound assignment
+// reads the target once and applies the operator to the old value and the value
+//
+//	x = 5;
+//	arr[i] += 1;	// arr and i, then arr[i] + 1
+//	obj.name = "x";
+func evalAssignmentExpression(node *ast.AssignmentExpression, env *object.Environment) object.Object {
+	ref, err := evalReference(node.Target, env)
+	if err != nil {
+		return err
+	}
+
+	var old object.Object
+	if node.Operator != "=" {
+		old = ref.get()
+		if isError(old) {
+			return old
+		}
+	}
+
+	val := Eval(node.Value, env)
+	if isError(val) {
+		return val
+	}
+
+	if node.Operator != "=" {
+		// += applies +, -= applies -, ...
+		val = evalInfixExpression(strings.TrimSuffix(node.Operator, "="), old, val)
+		if isError(val) {
+			return val
+		}
+	}
+
+	if err := ref.set(val); err != nil {
+		return err
+	}
+
+	return val
+}
+
+// evaluates an increment or a decrement of a number,
+// the prefix form evaluates to the new value and the postfix form to the old value
+//
+//	var i = 1;
+//	i++;	// 1, i is 2
+//	++i;	// 3, i is 3
+func evalUpdateExpression(node *ast.UpdateExpression, env *object.Environment) object.Object {
+	ref, err := evalReference(node.Target, env)
+	if err != nil {
+		return err
+	}
+
+	old := ref.get()
+	if isError(old) {
+		return old
+	}
+
+	if old == nil || !isNumber(old) {
+		if node.Prefix {
+			return newError("unknown operator: %s%s", node.Operator, typeOf(old))
+		}
+		return newError("unknown operator: %s%s", typeOf(old), node.Operator)
+	}
+
+	// ++ adds 1, -- subtracts 1
+	val := evalInfixExpression(node.Operator[:1], old, &object.Integer{Value: 1})
+	if isError(val) {
+		return val
+	}
+
+	if err := ref.set(val); err != nil {
+		return err
+	}
+
+	if node.Prefix {
+		return val
+	}
+	return old
+}
+
+// evaluates the parts of an assignment target, the object and the index or property,
+// and returns a reference to the value they point to
+//
+//	x		// the variable x
+//	arr[0]		// the first element of arr
+//	obj.name	// the "name" entry of the hash obj
+func evalReference(target ast.Expression, env *object.Environment) (*reference, object.Object) {
+	switch target := target.(type) {
+	case *ast.Identifier:
+		return &reference{
+			get: func() object.Object { return evalIdentifier(target, env) },
+			set: func(value object.Object) *object.Error {
+				if err := env.Assign(target.Value, value); err != nil {
+					return newError("%s", err)
+				}
+				return nil
+			},
+		}, nil
+
+	case *ast.IndexExpression:
+		left := Eval(target.Left, env)
+		if isError(left) {
+			return nil, left
+		}
+
+		index := Eval(target.Index, env)
+		if isError(index) {
+			return nil, index
+		}
+
+		return indexReference(left, index)
+
+	case *ast.MemberExpression:
+		left := Eval(target.Object, env)
+		if isError(left) {
+			return nil, left
+		}
+
+		hash, ok := left.(*object.Hash)
+		if !ok {
+			return nil, newError("property assignment not supported: %s", typeOf(left))
+		}
+
+		return hashReference(hash, &object.String{Value: target.Property.Value})
+
+	default:
+		// rejected by the parser
+		return nil, newError("cannot assign to %s", target.String())
+	}
+}
+
+// returns a reference to an array element or a hash entry
+//
+// Arrays can only be assigned within their length
+//
+//	[1, 2][1] = 5;	// [1, 5]
+//	[1, 2][2] = 5;	// index out of range
+func indexReference(left, index object.Object) (*reference, object.Object) {
+	switch left := left.(type) {
+	case *object.Array:
+		idx, ok := index.(*object.Integer)
+		if !ok {
+			return nil, newError("array index must be INTEGER, got %s", typeOf(index))
+		}
+
+		return &reference{
+			get: func() object.Object { return evalArrayIndexExpression(left, idx) },
+			set: func(value object.Object) *object.Error {
+				if idx.Value < 0 || idx.Value >= int64(len(left.Elements)) {
+					return newError("index out of range: %d with length %d", idx.Value, len(left.Elements))
+				}
+				left.Elements[idx.Value] = value
+				return nil
+			},
+		}, nil
+
+	case *object.Hash:
+		return hashReference(left, index)
+
+	default:
+		return nil, newError("index assignment not supported: %s", typeOf(left))
+	}
+}
+
+// returns a reference to the entry of a hash, the entry is added if it is missing
+func hashReference(hash *object.Hash, index object.Object) (*reference, object.Object) {
+	key, ok := index.(object.Hashable)
+	if !ok {
+		return nil, newError("unusable as hash key: %s", typeOf(index))
+	}
+
+	return &reference{
+		get: func() object.Object { return evalHashIndexExpression(hash, index) },
+		set: func(value object.Object) *object.Error {
+			hash.Set(key, object.HashPair{Key: index, Value: value})
+			return nil
+		},
+	}, nil
+}
+
+// returns the type of an object, NULL for a missing value
+func typeOf(obj object.Object) object.ObjectType {
+	if obj == nil {
+		return object.NULL_OBJ
+	}
+	return obj.Type()
+}
@@ -0,0 +1,135 @@
+package eval
+
+import (
+	"devscript/src/object"
+	"testing"
+)
+
+func TestCompoundAssignment(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{"var x = 5; x += 3; x;", 8},
+		{"var x = 5; x -= 3; x;", 2},
+		{"var x = 5; x *= 3; x;", 15},
+		{"var x = 15; x /= 3; x;", 5},
+		{"var x = 17; x %= 5; x;", 2},
+		{"var x = 5; x += 3;", 8},
+		{"var x = 1.5; x *= 2; x;", 3.0},
+		{`var s = "ab"; s += "c"; s;`, "abc"},
+		// assignments are right associative and evaluate to the assigned value
+		{"var a = 0; var b = 0; a = b = 4; a + b;", 8},
+		{"var a = 1; var b = 2; a += b += 3; a;", 6},
+		// closures update the captured variable
+		{"var total = 0; var add = func(n) { total += n; }; add(2); add(3); total;", 5},
+	}
+
+	for _, tt := range tests {
+		testObject(t, testEval(tt.input), tt.expected)
+	}
+}
+
+func TestUpdateExpressions(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{"var i = 5; i++;", 5},
+		{"var i = 5; i++; i;", 6},
+		{"var i = 5; ++i;", 6},
+		{"var i = 5; i--;", 5},
+		{"var i = 5; i--; i;", 4},
+		{"var i = 5; --i;", 4},
+		{"var f = 0.5; f++; f;", 1.5},
+		{"var i = 1; i++ + i++;", 3},
+		{"var i = 1; -i++;", -1},
+		{"var sum = 0; for (var i = 0; i < 4; i++) { sum += i; } sum;", 6},
+		{"var arr = [1, 2]; arr[1]++; arr[1];", 3},
+		{"var h = {\"n\": 1}; ++h.n; h.n;", 2},
+	}
+
+	for _, tt := range tests {
+		testObject(t, testEval(tt.input), tt.expected)
+	}
+}
+
+func TestIndexAndMemberAssignment(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{"var arr = [1, 2, 3]; arr[0] = 10; arr[0];", 10},
+		{"var arr = [1, 2, 3]; arr[2] *= 5; arr[2];", 15},
+		{"var arr = [1, 2, 3]; arr[1] = 7;", 7},
+		{"var arr = [[1], [2]]; arr[1][0] = 5; arr[1][0];", 5},
+		{`var h = {"a": 1}; h["a"] = 2; h["a"];`, 2},
+		{`var h = {}; h["new"] = 3; h["new"];`, 3},
+		{`var h = {}; h.name = "x"; h["name"];`, "x"},
+		{`var h = {"count": 1}; h.count += 4; h.count;`, 5},
+		{`var h = {"inner": {"v": 1}}; h.inner.v = 9; h["inner"]["v"];`, 9},
+		// arrays and hashes are shared, not copied
+		{"var a = [1]; var b = a; b[0] = 2; a[0];", 2},
+	}
+
+	for _, tt := range tests {
+		testObject(t, testEval(tt.input), tt.expected)
+	}
+}
+
+func TestAssignmentEvaluationOrder(t *testing.T) {
+	// the target is evaluated before the value, and only once
+	input := `
+	var log = "";
+	var arr = [0, 0];
+	var index = func() { log += "index "; 1 };
+	var value = func() { log += "value"; 5 };
+	arr[index()] += value();
+	printf("%s %v", log, arr);
+	`
+	_, stdout, _ := testEvalWithIO(input, "")
+
+	expected := "index value [0, 5]"
+	if stdout != expected {
+		t.Errorf("wrong evaluation order. expected=%q, got=%q", expected, stdout)
+	}
+}
+
+func TestAssignmentErrors(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"x += 1;", "identifier not found: x"},
+		{"x++;", "identifier not found: x"},
+		{"var arr = [1]; arr[1] = 2;", "index out of range: 1 with length 1"},
+		{"var arr = [1]; arr[-1] = 2;", "index out of range: -1 with length 1"},
+		{`var arr = [1]; arr["0"] = 2;`, "array index must be INTEGER, got STRING"},
+		{`var h = {}; h[[1]] = 2;`, "unusable as hash key: ARRAY"},
+		{`var s = "abc"; s[0] = "x";`, "index assignment not supported: STRING"},
+		{"var n = 1; n.x = 2;", "property assignment not supported: INTEGER"},
+		{`var s = "a"; s++;`, "unknown operator: STRING++"},
+		{"var b = true; --b;", "unknown operator: --BOOLEAN"},
+		{`var s = "a"; s -= 1;`, "type mismatch: STRING - INTEGER"},
+		{"const c = 1; c++;", "cannot assign to constant: c"},
+	}
+
+	for _, tt := range tests {
+		testErrorMessage(t, testEval(tt.input), tt.expected)
+	}
+}
+
+// checks an integer, float or string result
+func testObject(t *testing.T, obj object.Object, expected interface{}) bool {
+	switch expected := expected.(type) {
+	case int:
+		return testIntegerObject(t, obj, int64(expected))
+	case float64:
+		return testFloatObject(t, obj, expected)
+	case string:
+		return testStringObject(t, obj, expected)
+	default:
+		t.Errorf("type of expected value not handled. got=%T", expected)
+		return false
+	}
+}
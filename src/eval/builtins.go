@@ -2,40 +2,157 @@ package eval
 
 import (
 	"devscript/src/object"
+	"io"
+	"unicode/utf8"
 )
 
 var builtins = map[string]*object.Builtin{
-	"len":     {Function: lenFunction},
-	"print":   {Function: printFunction},
-	"println": {Function: printlnFunction},
+	"len":      {Function: lenFunction},
+	"bytes":    {Function: bytesFunction},
+	"print":    {Function: printFunction},
+	"println":  {Function: printlnFunction},
+	"eprint":   {Function: eprintFunction},
+	"eprintln": {Function: eprintlnFunction},
+	"format":   {Function: formatFunction},
+	"printf":   {Function: printfFunction},
+	"range":    {Function: rangeFunction},
+	"exit":     {Function: exitFunction},
+	"env":      {Function: envFunction},
+	"setenv":   {Function: setenvFunction},
+	"input":    {Function: inputFunction},
+	"readLine": {Function: readLineFunction},
+	"readAll":  {Function: readAllFunction},
 }
 
-// lenFunction returns the length of a string
-func lenFunction(args ...object.Object) object.Object {
+// lenFunction returns the length of a string, an array or a hash,
+// strings are measured in code points, see bytesFunction for the byte length
+//
+//	len("héllo");	// 5
+func lenFunction(ctx *object.Context, args ...object.Object) object.Object {
 	if len(args) != 1 {
 		return newError("wrong number of arguments. got=%d, want=1", len(args))
 	}
 
 	switch arg := args[0].(type) {
 	case *object.String:
-		return &object.Integer{Value: int64(len(arg.Value))}
+		return &object.Integer{Value: int64(utf8.RuneCountInString(arg.Value))}
+	case *object.Array:
+		return &object.Integer{Value: int64(len(arg.Elements))}
+	case *object.Hash:
+		return &object.Integer{Value: int64(len(arg.Pairs))}
 	default:
 		return newError("argument to `len` not supported, got %s", args[0].Type())
 	}
 }
 
-// Print function prints the value of the object
-func printFunction(args ...object.Object) object.Object {
+// bytesFunction returns the UTF-8 encoding of a string as an array of integers
+//
+//	bytes("hé");	// [104, 195, 169]
+func bytesFunction(ctx *object.Context, args ...object.Object) object.Object {
+	if len(args) != 1 {
+		return newError("wrong number of arguments. got=%d, want=1", len(args))
+	}
+
+	str, ok := args[0].(*object.String)
+	if !ok {
+		return newError("argument to `bytes` must be STRING, got %s", args[0].Type())
+	}
+
+	elements := make([]object.Object, len(str.Value))
+	for i := 0; i < len(str.Value); i++ {
+		elements[i] = &object.Integer{Value: int64(str.Value[i])}
+	}
+
+	return &object.Array{Elements: elements}
+}
+
+// Print function prints the value of the object to stdout
+func printFunction(ctx *object.Context, args ...object.Object) object.Object {
+	return writeObjects(ctx.Stdout, args, " ")
+}
+
+// Println function prints the value of the object with a newline to stdout
+func printlnFunction(ctx *object.Context, args ...object.Object) object.Object {
+	return writeObjects(ctx.Stdout, args, "\n")
+}
+
+// Eprint function prints the value of the object to stderr
+func eprintFunction(ctx *object.Context, args ...object.Object) object.Object {
+	return writeObjects(ctx.Stderr, args, " ")
+}
+
+// Eprintln function prints the value of the object with a newline to stderr
+func eprintlnFunction(ctx *object.Context, args ...object.Object) object.Object {
+	return writeObjects(ctx.Stderr, args, "\n")
+}
+
+// writes every object followed by the separator, the print functions evaluate to NULL
+func writeObjects(out io.Writer, args []object.Object, separator string) object.Object {
 	for _, arg := range args {
-		print(arg.Inspect() + " ")
+		io.WriteString(out, arg.Inspect()+separator)
 	}
-	return nil
+	return NULL
 }
 
-// Println function prints the value of the object with a newline
-func printlnFunction(args ...object.Object) object.Object {
+// rangeFunction returns a lazy range of integers
+//
+//	range(end)		// 0, 1, ..., end - 1
+//	range(start, end)	// start, start + 1, ..., end - 1
+//	range(start, end, step)	// start, start + step, ... up to end (exclusive)
+func rangeFunction(ctx *object.Context, args ...object.Object) object.Object {
+	if len(args) < 1 || len(args) > 3 {
+		return newError("wrong number of arguments. got=%d, want=1 to 3", len(args))
+	}
+
+	values := []int64{}
 	for _, arg := range args {
-		println(arg.Inspect())
+		integer, ok := arg.(*object.Integer)
+		if !ok {
+			return newError("argument to `range` must be INTEGER, got %s", arg.Type())
+		}
+		values = append(values, integer.Value)
+	}
+
+	rng := &object.Range{Start: 0, Step: 1}
+	switch len(values) {
+	case 1:
+		rng.End = values[0]
+	case 2:
+		rng.Start, rng.End = values[0], values[1]
+	case 3:
+		rng.Start, rng.End, rng.Step = values[0], values[1], values[2]
 	}
-	return nil
+
+	if rng.Step == 0 {
+		return newError("range step must not be zero")
+	}
+
+	return rng
+}
+
+// exitFunction stops the program with the exit code, 0 if no code is given.
+// The code must be between 0 and 255, the operating system would cut larger codes
+// down to their last byte and exit(256) would report success.
+//
+//	exit();		// exit code 0
+//	exit(2);	// exit code 2
+//	exit(256);	// error
+func exitFunction(ctx *object.Context, args ...object.Object) object.Object {
+	if len(args) > 1 {
+		return newError("wrong number of arguments. got=%d, want=0 or 1", len(args))
+	}
+
+	if len(args) == 0 {
+		return &object.Exit{Code: 0}
+	}
+
+	code, ok := args[0].(*object.Integer)
+	if !ok {
+		return newError("argument to `exit` must be INTEGER, got %s", args[0].Type())
+	}
+	if code.Value < 0 || code.Value > 255 {
+		return newError("exit code must be between 0 and 255, got %d", code.Value)
+	}
+
+	return &object.Exit{Code: int(code.Value)}
 }
@@ -0,0 +1,129 @@
+package eval
+
+import (
+	"bufio"
+	"devscript/src/object"
+	"io"
+	"os"
+	"strings"
+)
+
+// SetArgs defines the args array of a script in the environment
+//
+//	devscript run main.ds -- a b	// args == ["a", "b"]
+func SetArgs(env *object.Environment, args []string) {
+	elements := []object.Object{}
+	for _, arg := range args {
+		elements = append(elements, &object.String{Value: arg})
+	}
+
+	env.Set("args", &object.Array{Elements: elements})
+}
+
+// envFunction returns the value of an environment variable, null if it is not set
+//
+//	env("HOME");	// "/home/dev"
+func envFunction(ctx *object.Context, args ...object.Object) object.Object {
+	if len(args) != 1 {
+		return newError("wrong number of arguments. got=%d, want=1", len(args))
+	}
+
+	name, ok := args[0].(*object.String)
+	if !ok {
+		return newError("argument to `env` must be STRING, got %s", args[0].Type())
+	}
+
+	value, ok := os.LookupEnv(name.Value)
+	if !ok {
+		return NULL
+	}
+
+	return &object.String{Value: value}
+}
+
+// setenvFunction sets an environment variable,
+// it is seen by env() and by the programs the script starts
+//
+//	setenv("MODE", "debug");
+func setenvFunction(ctx *object.Context, args ...object.Object) object.Object {
+	if len(args) != 2 {
+		return newError("wrong number of arguments. got=%d, want=2", len(args))
+	}
+
+	for _, arg := range args {
+		if arg.Type() != object.STRING_OBJ {
+			return newError("argument to `setenv` must be STRING, got %s", arg.Type())
+		}
+	}
+
+	name, value := args[0].(*object.String).Value, args[1].(*object.String).Value
+	if err := os.Setenv(name, value); err != nil {
+		return newError("setenv %s: %s", name, err)
+	}
+
+	return NULL
+}
+
+// inputFunction prints the prompt to stdout and reads a line from stdin,
+// without the line break. Returns null at the end of the input.
+//
+//	var name = input("name: ");
+func inputFunction(ctx *object.Context, args ...object.Object) object.Object {
+	if len(args) > 1 {
+		return newError("wrong number of arguments. got=%d, want=0 or 1", len(args))
+	}
+
+	if len(args) == 1 {
+		prompt, ok := args[0].(*object.String)
+		if !ok {
+			return newError("argument to `input` must be STRING, got %s", args[0].Type())
+		}
+		io.WriteString(ctx.Stdout, prompt.Value)
+	}
+
+	return readLine(ctx.Stdin)
+}
+
+// readLineFunction reads a line from stdin, without the line break.
+// Returns null at the end of the input.
+//
+//	var line = readLine();
+func readLineFunction(ctx *object.Context, args ...object.Object) object.Object {
+	if len(args) != 0 {
+		return newError("wrong number of arguments. got=%d, want=0", len(args))
+	}
+
+	return readLine(ctx.Stdin)
+}
+
+// readAllFunction reads stdin up to the end of the input
+//
+//	var text = readAll();
+func readAllFunction(ctx *object.Context, args ...object.Object) object.Object {
+	if len(args) != 0 {
+		return newError("wrong number of arguments. got=%d, want=0", len(args))
+	}
+
+	content, err := io.ReadAll(ctx.Stdin)
+	if err != nil {
+		return newError("readAll: %s", err)
+	}
+
+	return &object.String{Value: string(content)}
+}
+
+// reads a line without the line break, null at the end of the input
+func readLine(stdin *bufio.Reader) object.Object {
+	line, err := stdin.ReadString('\n')
+	if err != nil && (err != io.EOF || line == "") {
+		if err == io.EOF {
+			return NULL
+		}
+		return newError("readLine: %s", err)
+	}
+
+	line = strings.TrimSuffix(line, "\n")
+	line = strings.TrimSuffix(line, "\r")
+
+	return &object.String{Value: line}
+}
@@ -0,0 +1,85 @@
+package eval
+
+import (
+	"devscript/src/object"
+	"testing"
+)
+
+func TestEnvFunctions(t *testing.T) {
+	t.Setenv("DEVSCRIPT_TEST", "value")
+
+	testStringObject(t, testEval(`env("DEVSCRIPT_TEST")`), "value")
+	testNullObject(t, testEval(`env("DEVSCRIPT_TEST_MISSING")`))
+	testStringObject(t, testEval(`setenv("DEVSCRIPT_TEST", "changed"); env("DEVSCRIPT_TEST")`), "changed")
+
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{`env(1)`, "argument to `env` must be STRING, got INTEGER"},
+		{`env()`, "wrong number of arguments. got=0, want=1"},
+		{`setenv("A", 1)`, "argument to `setenv` must be STRING, got INTEGER"},
+		{`setenv("A")`, "wrong number of arguments. got=1, want=2"},
+	}
+
+	for _, tt := range tests {
+		testErrorMessage(t, testEval(tt.input), tt.expected)
+	}
+}
+
+func TestInputFunctions(t *testing.T) {
+	input := `
+	var first = readLine();
+	var second = input("name: ");
+	var rest = readAll();
+	println(first, second, rest);
+	[readLine(), readAll()];
+	`
+
+	evaluated, stdout, _ := testEvalWithIO(input, "first\r\nsecond\nrest\nof input")
+
+	expected := "name: first\nsecond\nrest\nof input\n"
+	if stdout != expected {
+		t.Errorf("wrong stdout. expected=%q, got=%q", expected, stdout)
+	}
+
+	// null and an empty string at the end of the input
+	if evaluated.Inspect() != `[null, ""]` {
+		t.Errorf("wrong result at the end of the input. got=%s", evaluated.Inspect())
+	}
+
+	evaluated, _, _ = testEvalWithIO(`readLine()`, "no line break")
+	testStringObject(t, evaluated, "no line break")
+
+	testErrorMessage(t, testEval(`input(1)`), "argument to `input` must be STRING, got INTEGER")
+	testErrorMessage(t, testEval(`readLine(1)`), "wrong number of arguments. got=1, want=0")
+}
+
+func TestArgs(t *testing.T) {
+	env := object.NewEnvironment()
+	SetArgs(env, []string{"a", "-b"})
+
+	args, ok := env.Get("args")
+	if !ok {
+		t.Fatalf("args is not defined")
+	}
+
+	if args.Inspect() != `["a", "-b"]` {
+		t.Errorf("wrong args. got=%s", args.Inspect())
+	}
+}
+
+func testErrorMessage(t *testing.T, obj object.Object, expected string) bool {
+	errObj, ok := obj.(*object.Error)
+	if !ok {
+		t.Errorf("object is not Error. got=%T (%+v)", obj, obj)
+		return false
+	}
+
+	if errObj.Message != expected {
+		t.Errorf("wrong error message. expected=%q, got=%q", expected, errObj.Message)
+		return false
+	}
+
+	return true
+}
@@ -1,7 +1,11 @@
 package eval
 
 import (
+	"bytes"
+	"devscript/src/lexer"
 	"devscript/src/object"
+	"devscript/src/parser"
+	"strings"
 	"testing"
 )
 
@@ -13,6 +17,12 @@ func TestLenFunction(t *testing.T) {
 		{`len("")`, 0},
 		{`len("four")`, 4},
 		{`len("hello world")`, 11},
+		// strings are measured in code points
+		{`len("héllo")`, 5},
+		{`len("😀")`, 1},
+		{`len(bytes("héllo"))`, 6},
+		{`len([])`, 0},
+		{`len([1, 2, 3])`, 3},
 		{`len(1)`, "argument to `len` not supported, got INTEGER"},
 		{`len("one", "two")`, "wrong number of arguments. got=2, want=1"},
 	}
@@ -36,12 +46,161 @@ func TestLenFunction(t *testing.T) {
 	}
 }
 
+func TestBytesFunction(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`bytes("")`, []int64{}},
+		{`bytes("hi")`, []int64{104, 105}},
+		{`bytes("é")`, []int64{195, 169}},
+		{`bytes("\u{1F600}")`, []int64{240, 159, 152, 128}},
+		{`bytes(1)`, "argument to `bytes` must be STRING, got INTEGER"},
+		{`bytes()`, "wrong number of arguments. got=0, want=1"},
+	}
+
+	for _, tt := range tests {
+		evaluated := testEval(tt.input)
+		switch expected := tt.expected.(type) {
+		case []int64:
+			array, ok := evaluated.(*object.Array)
+			if !ok {
+				t.Errorf("object is not Array. got=%T (%+v)", evaluated, evaluated)
+				continue
+			}
+
+			if len(array.Elements) != len(expected) {
+				t.Errorf("wrong number of elements. expected=%d, got=%d", len(expected), len(array.Elements))
+				continue
+			}
+
+			for i, value := range expected {
+				testIntegerObject(t, array.Elements[i], value)
+			}
+		case string:
+			testErrorMessage(t, evaluated, expected)
+		}
+	}
+}
+
+// evaluates the input with stdin read from the string
+// and returns the result with the output written to stdout and stderr
+func testEvalWithIO(input string, stdin string) (object.Object, string, string) {
+	var stdout, stderr bytes.Buffer
+	ctx := object.NewContext(strings.NewReader(stdin), &stdout, &stderr)
+
+	l := lexer.New(input)
+	p := parser.New(l)
+	program := p.ParseProgram()
+	env := object.NewEnvironmentWithContext(ctx)
+
+	return Eval(program, env), stdout.String(), stderr.String()
+}
+
+func TestPrintResultIsNull(t *testing.T) {
+	evaluated, stdout, _ := testEvalWithIO(`println(println(1)); var x = println("a"); x ?? 2`, "")
+	testIntegerObject(t, evaluated, 2)
+
+	if stdout != "1\nnull\na\n" {
+		t.Errorf("wrong stdout. got=%q", stdout)
+	}
+}
+
+// strings inside arrays and hashes are quoted, like format does
+func TestPrintNestedStrings(t *testing.T) {
+	input := `var h = {"x": 2, "y": ["a", 1], 3: "b"}; println(h); printf("%v\n", h); println("c");`
+
+	_, stdout, _ := testEvalWithIO(input, "")
+
+	expected := "{\"x\": 2, \"y\": [\"a\", 1], 3: \"b\"}\n{\"x\": 2, \"y\": [\"a\", 1], 3: \"b\"}\nc\n"
+	if stdout != expected {
+		t.Errorf("wrong stdout. expected=%q, got=%q", expected, stdout)
+	}
+}
+
 func TestPrintFunction(t *testing.T) {
 	input := `print("Hello World!")`
 
-	evaluated := testEval(input)
+	evaluated, stdout, stderr := testEvalWithIO(input, "")
+	testNullObject(t, evaluated)
+
+	if stdout != "Hello World! " {
+		t.Errorf("wrong stdout. got=%q", stdout)
+	}
+
+	if stderr != "" {
+		t.Errorf("expected no output on stderr. got=%q", stderr)
+	}
+}
+
+func TestPrintFunctions(t *testing.T) {
+	tests := []struct {
+		input          string
+		expectedStdout string
+		expectedStderr string
+	}{
+		{`print(1, "a", true);`, "1 a true ", ""},
+		{`println(1); println([1, 2]);`, "1\n[1, 2]\n", ""},
+		{`eprint("warning:", 1);`, "", "warning: 1 "},
+		{`eprintln("error"); println("out");`, "out\n", "error\n"},
+		{`var f = func(x) { println(x); }; f(5);`, "5\n", ""},
+	}
+
+	for _, tt := range tests {
+		_, stdout, stderr := testEvalWithIO(tt.input, "")
+
+		if stdout != tt.expectedStdout {
+			t.Errorf("wrong stdout for %q. expected=%q, got=%q", tt.input, tt.expectedStdout, stdout)
+		}
+
+		if stderr != tt.expectedStderr {
+			t.Errorf("wrong stderr for %q. expected=%q, got=%q", tt.input, tt.expectedStderr, stderr)
+		}
+	}
+}
+
+func TestExitFunction(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`exit();`, 0},
+		{`exit(3);`, 3},
+		// exit stops the program
+		{`exit(1); 5 / 0;`, 1},
+		{`var f = func() { exit(2); return 1; }; f(); 5 / 0;`, 2},
+		{`while (true) { exit(4); }`, 4},
+		// exit can't be caught
+		{`try { exit(5); } catch (e) { 1; }`, 5},
+		{`exit("1")`, "argument to `exit` must be INTEGER, got STRING"},
+		{`exit(1, 2)`, "wrong number of arguments. got=2, want=0 or 1"},
+		{`exit(256)`, "exit code must be between 0 and 255, got 256"},
+		{`exit(-1)`, "exit code must be between 0 and 255, got -1"},
+	}
+
+	for _, tt := range tests {
+		evaluated := testEval(tt.input)
+		switch expected := tt.expected.(type) {
+		case int:
+			exit, ok := evaluated.(*object.Exit)
+			if !ok {
+				t.Errorf("object is not Exit. got=%T (%+v)", evaluated, evaluated)
+				continue
+			}
 
-	if evaluated != nil {
-		t.Errorf("evaluated should be nil. got=%T (%+v)", evaluated, evaluated)
+			if exit.Code != expected {
+				t.Errorf("wrong exit code. expected=%d, got=%d", expected, exit.Code)
+			}
+		case string:
+			errObj, ok := evaluated.(*object.Error)
+			if !ok {
+				t.Errorf("object is not Error. got=%T (%+v)", evaluated, evaluated)
+				continue
+			}
+
+			if errObj.Message != expected {
+				t.Errorf("wrong error message. expected=%q, got=%q", expected, errObj.Message)
+			}
+		}
 	}
 }
@@ -8,14 +8,20 @@ import (
 // return a new error object.
 //
 // This is a helper function to make it easier to create new error objects.
+// The position and the stack are filled in by Eval() from the node that raised the error.
 func newError(format string, a ...interface{}) *object.Error {
-	return &object.Error{Message: fmt.Sprintf(format, a...)}
+	return &object.Error{
+		Message: fmt.Sprintf(format, a...),
+		Kind:    object.RUNTIME_ERROR,
+	}
 }
 
 // check if an object is an error object.
+//
+// An exit unwinds the evaluation like an error, so it is also reported as one.
 func isError(obj object.Object) bool {
 	if obj != nil {
-		return obj.Type() == object.ERROR_OBJ
+		return obj.Type() == object.ERROR_OBJ || obj.Type() == object.EXIT_OBJ
 	}
 	return false
 }
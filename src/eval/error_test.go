@@ -54,6 +54,70 @@ func TestErrorHandling(t *testing.T) {
 			`"Hello" - "World"`,
 			"unknown operator: STRING - STRING",
 		},
+		{
+			"1.5 + true;",
+			"type mismatch: FLOAT + BOOLEAN",
+		},
+		{
+			"1.5 & 1",
+			"unknown operator: FLOAT & INTEGER",
+		},
+		{
+			"~true",
+			"unknown operator: ~BOOLEAN",
+		},
+		{
+			"1 << -1",
+			"negative shift count: -1",
+		},
+		{
+			"1 / 0",
+			"division by zero",
+		},
+		{
+			"var x = 0; 10 / x;",
+			"division by zero",
+		},
+		{
+			"1 % 0",
+			"modulo by zero",
+		},
+		{
+			"1.5 / 0",
+			"division by zero",
+		},
+		{
+			"func add(x, y) { x + y; } add(1);",
+			"add expects 2 arguments, got 1",
+		},
+		{
+			"func double(x) { x * 2; } double(1, 2);",
+			"double expects 1 argument, got 2",
+		},
+		{
+			"var add = func(x, y) { x + y; }; add();",
+			"function expects 2 arguments, got 0",
+		},
+		{
+			"5(1)",
+			"not a function: INTEGER",
+		},
+		{
+			"var forever = func(n) { forever(n + 1); }; forever(0);",
+			"maximum call depth of 10000 exceeded in function",
+		},
+		{
+			`5[0]`,
+			"index operator not supported: INTEGER",
+		},
+		{
+			`{"name": "DevScript"}[func(x) { x }];`,
+			"unusable as hash key: FUNCTION",
+		},
+		{
+			`var h = {func(x) { x }: 1};`,
+			"unusable as hash key: FUNCTION",
+		},
 	}
 
 	for _, tt := range tests {
@@ -69,6 +133,94 @@ func TestErrorHandling(t *testing.T) {
 	}
 }
 
+func TestErrorPositions(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"5 + true;", "ERROR: 1:1: type mismatch: INTEGER + BOOLEAN"},
+		{"var x = 1;\nvar y = x / 0;", "ERROR: 2:9: division by zero"},
+		{"foobar", "ERROR: 1:1: identifier not found: foobar"},
+		{"var f = func(x) {\n  if (x) {\n    -true;\n  }\n};\nf(1);", "ERROR: 3:5: unknown operator: -BOOLEAN"},
+	}
+
+	for _, tt := range tests {
+		evaluated := testEval(tt.input)
+		errObj, ok := evaluated.(*object.Error)
+		if !ok {
+			t.Errorf("no error object returned. got=%T (%+v)", evaluated, evaluated)
+			continue
+		}
+		if errObj.Inspect() != tt.expected {
+			t.Errorf("wrong error. expected=%q, got=%q", tt.expected, errObj.Inspect())
+		}
+	}
+}
+
+// errors span the whole expression they are raised at
+func TestErrorSpans(t *testing.T) {
+	tests := []struct {
+		input string
+		start string
+		end   string
+	}{
+		{`var longName = 1; longName + true;`, "1:19", "1:34"},
+		{"var add = func(a, b) { a + b; }; add(1);", "1:34", "1:40"},
+		{"var h = {}; h.a.b;", "1:13", "1:18"},
+		{"var arr = [1]; arr[-1] + arr[\"a\"];", "1:26", "1:34"},
+		{"-!true;", "1:1", "1:7"},
+		{"true ? 1 + true : 2;", "1:8", "1:16"},
+		{"const c = 1; c = 1 + 1;", "1:14", "1:23"},
+	}
+
+	for _, tt := range tests {
+		evaluated := testEval(tt.input)
+		errObj, ok := evaluated.(*object.Error)
+		if !ok {
+			t.Errorf("no error object returned for %q. got=%T (%+v)", tt.input, evaluated, evaluated)
+			continue
+		}
+		if errObj.Pos.String() != tt.start || errObj.End.String() != tt.end {
+			t.Errorf("wrong span for %q. expected=%s-%s, got=%s-%s (%s)",
+				tt.input, tt.start, tt.end, errObj.Pos, errObj.End, errObj.Message)
+		}
+	}
+}
+
+func TestErrorStack(t *testing.T) {
+	input := `var inner = func(x) {
+  return x / 0;
+};
+var outer = func(x) {
+  return inner(x + 1);
+};
+outer(1);`
+
+	evaluated := testEval(input)
+	errObj, ok := evaluated.(*object.Error)
+	if !ok {
+		t.Fatalf("no error object returned. got=%T (%+v)", evaluated, evaluated)
+	}
+
+	expected := []string{"function at 7:1", "function at 5:10"}
+	if len(errObj.Stack) != len(expected) {
+		t.Fatalf("wrong stack size. expected=%d, got=%d (%+v)", len(expected), len(errObj.Stack), errObj.Stack)
+	}
+
+	for i, frame := range errObj.Stack {
+		got := frame.Function + " at " + frame.Pos.String()
+		if got != expected[i] {
+			t.Errorf("wrong frame %d. expected=%q, got=%q", i, expected[i], got)
+		}
+	}
+
+	// the call stack is empty again after the error
+	evaluated = testEval("5 + true;")
+	if errObj, ok := evaluated.(*object.Error); !ok || len(errObj.Stack) != 0 {
+		t.Errorf("expected error without stack, got=%+v", evaluated)
+	}
+}
+
 func testNullObject(t *testing.T, obj object.Object) bool {
 	if obj != NULL {
 		t.Errorf("object is not NULL. got=%T (%+v)", obj, obj)
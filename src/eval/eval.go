@@ -6,14 +6,31 @@ import (
 )
 
 var (
-	NULL  = &object.Null{}
-	TRUE  = &object.Boolean{Value: true}
-	FALSE = &object.Boolean{Value: false}
-	ZERO  = &object.Integer{Value: 0}
+	NULL     = &object.Null{}
+	TRUE     = &object.Boolean{Value: true}
+	FALSE    = &object.Boolean{Value: false}
+	ZERO     = &object.Integer{Value: 0}
+	BREAK    = &object.Break{}
+	CONTINUE = &object.Continue{}
 )
 
 // Eval evaluates an AST
+//
+// Errors get the position of the innermost node they were raised at,
+// and a snapshot of the function calls being evaluated there
 func Eval(node ast.Node, env *object.Environment) object.Object {
+	result := evalNode(node, env)
+
+	if err, ok := result.(*object.Error); ok && !err.Pos.IsValid() {
+		err.Pos, err.End = node.Pos(), node.End()
+		err.Stack = append([]object.Frame(nil), env.Context().CallStack...)
+	}
+
+	return result
+}
+
+// evalNode evaluates a single node of the AST
+func evalNode(node ast.Node, env *object.Environment) object.Object {
 	switch node := node.(type) {
 
 	// Program is the root node of the AST
@@ -22,14 +39,7 @@ func Eval(node ast.Node, env *object.Environment) object.Object {
 
 	// Evaluate Variable Statements
 	case *ast.VarStatement:
-		{
-			val := Eval(node.Value, env)
-			if isError(val) {
-				return val
-			}
-			env.Set(node.Name.Value, val)
-			return val
-		}
+		return evalVarStatement(node, env)
 
 	// Evaluate Expressions
 	case *ast.ExpressionStatement:
@@ -41,22 +51,11 @@ func Eval(node ast.Node, env *object.Environment) object.Object {
 
 	// Evaluate Assignment Expressions
 	case *ast.AssignmentExpression:
-		{
-			val := Eval(node.Value, env)
-			if isError(val) {
-				return val
-			}
+		return evalAssignmentExpression(node, env)
 
-			// Check if the variable exists
-			_, ok := env.Get(node.Name.Value)
-			if !ok {
-				return newError("identifier not found: " + node.Name.Value)
-			}
-
-			// set value to the variable
-			env.Set(node.Name.Value, val)
-			return val
-		}
+	// Evaluate Increments and Decrements
+	case *ast.UpdateExpression:
+		return evalUpdateExpression(node, env)
 
 	// Evaluate Integer Literals
 	case *ast.IntegerLiteral:
@@ -68,14 +67,26 @@ func Eval(node ast.Node, env *object.Environment) object.Object {
 			return &object.Integer{Value: node.Value}
 		}
 
+	// Evaluate Float Literals
+	case *ast.FloatLiteral:
+		return &object.Float{Value: node.Value}
+
 	// Evaluate String Literals
 	case *ast.StringLiteral:
 		return &object.String{Value: node.Value}
 
+	// Evaluate Strings with embedded expressions
+	case *ast.InterpolatedString:
+		return evalInterpolatedString(node, env)
+
 	// Evaluate Boolean Literals
 	case *ast.Boolean:
 		return nativeBoolToBooleanObject(node.Value)
 
+	// Evaluate the null Literal
+	case *ast.Null:
+		return NULL
+
 	// Evaluate Prefix Expressions
 	case *ast.PrefixExpression:
 		{
@@ -90,6 +101,11 @@ func Eval(node ast.Node, env *object.Environment) object.Object {
 	// Evaluate Infix Expressions
 	case *ast.InfixExpression:
 		{
+			// &&, || and ?? only evaluate the right operand when needed
+			if node.Operator == "&&" || node.Operator == "||" || node.Operator == "??" {
+				return evalLogicalExpression(node, env)
+			}
+
 			left := Eval(node.Left, env)
 			if isError(left) {
 				return left
@@ -104,12 +120,16 @@ func Eval(node ast.Node, env *object.Environment) object.Object {
 
 	// Evaluate Block Statements
 	case *ast.BlockStatement:
-		return evalBlockStatement(node, env)
+		return evalBlockStatement(node, object.NewBlockEnvironment(env))
 
 	// Evaluate If Expressions
 	case *ast.IfExpression:
 		return evalIfExpression(node, env)
 
+	// Evaluate Conditional Expressions
+	case *ast.ConditionalExpression:
+		return evalConditionalExpression(node, env)
+
 	// Evaluate Function Expression
 	case *ast.FunctionExpression:
 		return evalFunctionExpression(node, env)
@@ -129,6 +149,67 @@ func Eval(node ast.Node, env *object.Environment) object.Object {
 			return evalCallExpression(node, function, env)
 		}
 
+	// Evaluate Array Literals
+	case *ast.ArrayLiteral:
+		{
+			elements := evalExpressions(node.Elements, env)
+			if len(elements) == 1 && isError(elements[0]) {
+				return elements[0]
+			}
+			return &object.Array{Elements: elements}
+		}
+
+	// Evaluate Hash Literals
+	case *ast.HashLiteral:
+		return evalHashLiteral(node, env)
+
+	// Evaluate Index Expressions
+	case *ast.IndexExpression:
+		{
+			left := Eval(node.Left, env)
+			if isError(left) {
+				return left
+			}
+
+			index := Eval(node.Index, env)
+			if isError(index) {
+				return index
+			}
+
+			return evalIndexExpression(left, index)
+		}
+
+	// Evaluate Member Expressions
+	case *ast.MemberExpression:
+		{
+			left := Eval(node.Object, env)
+			if isError(left) {
+				return left
+			}
+
+			return evalMemberExpression(left, node.Property.Value)
+		}
+
+	// Evaluate Loops
+	case *ast.WhileStatement:
+		return evalWhileStatement(node, env)
+	case *ast.ForStatement:
+		return evalForStatement(node, env)
+	case *ast.ForInStatement:
+		return evalForInStatement(node, env)
+
+	// Evaluate Loop Control Statements
+	case *ast.BreakStatement:
+		return BREAK
+	case *ast.ContinueStatement:
+		return CONTINUE
+
+	// Evaluate Exception Handling Statements
+	case *ast.ThrowStatement:
+		return evalThrowStatement(node, env)
+	case *ast.TryStatement:
+		return evalTryStatement(node, env)
+
 	// Evaluate Return Statements
 	case *ast.ReturnStatement:
 		{
@@ -152,7 +233,7 @@ func evalProgram(node *ast.Program, env *object.Environment) object.Object {
 		switch result := result.(type) {
 		case *object.ReturnValue:
 			return result.Value
-		case *object.Error:
+		case *object.Error, *object.Exit:
 			return result
 		}
 	}
@@ -36,10 +36,26 @@ func TestEvalIntegerExpression(t *testing.T) {
 		{"5 + 2 * 10", 25},
 		{"20 + 2 * -10", 0},
 		{"50 / 2 * 2 + 10", 60},
+		// an exact division of integers stays an integer
+		{"8 / 2", 4},
+		{"-9 / 3", -3},
 		{"2 * (5 + 10)", 30},
 		{"3 * 3 * 3 + 10", 37},
 		{"3 * (3 * 3) + 10", 37},
 		{"(5 + 10 * 2 + 15 / 3) * 2 + -10", 50},
+		{"7 % 3", 1},
+		{"-7 % 3", -1},
+		{"2 ** 10", 1024},
+		{"2 ** 3 ** 2", 512},
+		{"-2 ** 2", -4},
+		{"3 ** 0", 1},
+		{"6 & 3", 2},
+		{"6 | 3", 7},
+		{"6 ^ 3", 5},
+		{"~5", -6},
+		{"1 << 3", 8},
+		{"-16 >> 2", -4},
+		{"1 | 2 ^ 3 & 4", 3},
 	}
 
 	for _, tt := range tests {
@@ -87,6 +103,14 @@ func TestEvalBooleanExpression(t *testing.T) {
 		{"(1 < 2) == false", false},
 		{"(1 > 2) == true", false},
 		{"(1 > 2) == false", true},
+		{"1 <= 2", true},
+		{"2 <= 2", true},
+		{"3 <= 2", false},
+		{"1 >= 2", false},
+		{"2 >= 2", true},
+		{"3 >= 2", true},
+		{"1.5 <= 1.5", true},
+		{"2 >= 2.5", false},
 		// Zero values
 		// TODO: To be implemented
 		// {"0 == true", false},
@@ -152,6 +176,28 @@ func TestAssignmentEvaluation(t *testing.T) {
 	testIntegerObject(t, evaluated, 10)
 }
 
+func TestNullLiteral(t *testing.T) {
+	testNullObject(t, testEval("null"))
+	testNullObject(t, testEval("var x = null; x"))
+
+	tests := []struct {
+		input    string
+		expected bool
+	}{
+		{"null == null", true},
+		{"null != null", false},
+		{"var x = null; x == null", true},
+		{"[1, 2][5] == null", true},
+		{`{"a": 1}["a"] == null`, false},
+		{"0 == null", false},
+		{"!null", true},
+	}
+
+	for _, tt := range tests {
+		testBooleanObject(t, testEval(tt.input), tt.expected)
+	}
+}
+
 // Function to test string evaluation
 func TestStringEvaluation(t *testing.T) {
 	tests := []struct {
@@ -160,6 +206,10 @@ func TestStringEvaluation(t *testing.T) {
 	}{
 		{"\"hello\"", "hello"},
 		{"\"hello world\"", "hello world"},
+		{`"tab\tquote\" backslash\\"`, "tab\tquote\" backslash\\"},
+		{`"line\nbreak\r"`, "line\nbreak\r"},
+		{`"\u{48}i \u{1F600}"`, "Hi 😀"},
+		{"`raw \\n\nstring`", "raw \\n\nstring"},
 	}
 
 	for _, tt := range tests {
@@ -199,6 +249,34 @@ func TestStringComparison(t *testing.T) {
 	}
 }
 
+func TestStringIndexExpressions(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`"abc"[0]`, "a"},
+		{`"abc"[2]`, "c"},
+		// strings are indexed by code point
+		{`"héllo"[1]`, "é"},
+		{`"héllo"[2]`, "l"},
+		{`"a😀b"[1]`, "😀"},
+		{`var s = "a😀b"; s[len(s) - 1]`, "b"},
+		{`"abc"[3]`, nil},
+		{`"abc"[-1]`, nil},
+		{`""[0]`, nil},
+	}
+
+	for _, tt := range tests {
+		evaluated := testEval(tt.input)
+		str, ok := tt.expected.(string)
+		if ok {
+			testStringObject(t, evaluated, str)
+		} else {
+			testNullObject(t, evaluated)
+		}
+	}
+}
+
 func testStringObject(t *testing.T, evaluated object.Object, expected string) bool {
 	result, ok := evaluated.(*object.String)
 	if !ok {
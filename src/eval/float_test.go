@@ -0,0 +1,91 @@
+package eval
+
+import (
+	"devscript/src/object"
+	"testing"
+)
+
+func TestEvalFloatExpression(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected float64
+	}{
+		{"3.14", 3.14},
+		{"-2.5", -2.5},
+		{"-(-2.5)", 2.5},
+		{"1e-9", 1e-9},
+		{"0.5 + 0.25", 0.75},
+		{"7 / 2.0", 3.5},
+		{"7.0 / 2", 3.5},
+		// dividing integers gives a float when the division is not exact
+		{"7 / 2", 3.5},
+		{"-7 / 2", -3.5},
+		{"1 / 4", 0.25},
+		{"var x = 7; x /= 2; x;", 3.5},
+		{"1 + 0.5", 1.5},
+		{"2 * 1.5 - 1", 2},
+		{"(1 + 2) * 0.5", 1.5},
+		{"5.5 % 2", 1.5},
+		{"2 ** -1", 0.5},
+		{"4 ** 0.5", 2},
+		{"2.0 ** 3", 8},
+	}
+
+	for _, tt := range tests {
+		evaluated := testEval(tt.input)
+		testFloatObject(t, evaluated, tt.expected)
+	}
+}
+
+func TestEvalFloatComparison(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected bool
+	}{
+		{"1.5 < 2", true},
+		{"2 > 1.5", true},
+		{"1.0 == 1", true},
+		{"1 != 1.0", false},
+		{"0.1 + 0.2 == 0.3", false},
+	}
+
+	for _, tt := range tests {
+		evaluated := testEval(tt.input)
+		testBooleanObject(t, evaluated, tt.expected)
+	}
+}
+
+func TestFloatInspect(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"3.14", "3.14"},
+		{"2.0", "2.0"},
+		{"4 / 2.0", "2.0"},
+		{"1e-9", "1e-09"},
+		{"-0.5", "-0.5"},
+	}
+
+	for _, tt := range tests {
+		evaluated := testEval(tt.input)
+		if evaluated.Inspect() != tt.expected {
+			t.Errorf("wrong Inspect output for %q. expected=%q, got=%q", tt.input, tt.expected, evaluated.Inspect())
+		}
+	}
+}
+
+func testFloatObject(t *testing.T, obj object.Object, expected float64) bool {
+	result, ok := obj.(*object.Float)
+	if !ok {
+		t.Errorf("object is not Float. got=%T (%+v)", obj, obj)
+		return false
+	}
+
+	if result.Value != expected {
+		t.Errorf("object has wrong value. got=%g, want=%g", result.Value, expected)
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,178 @@
+package eval
+
+import (
+	"devscript/src/object"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// formatFunction returns the format string with every verb replaced by the next argument
+//
+//	format("%s is %d years old", "Ada", 36);	// "Ada is 36 years old"
+//	format("%-8s|%6.2f", "total", 3.14159);	// "total   |  3.14"
+func formatFunction(ctx *object.Context, args ...object.Object) object.Object {
+	text, err := formatArgs("format", args)
+	if err != nil {
+		return err
+	}
+
+	return &object.String{Value: text}
+}
+
+// printfFunction prints the format string with every verb replaced by the next argument to stdout
+//
+//	printf("%d%%\n", 50);	// 50%
+func printfFunction(ctx *object.Context, args ...object.Object) object.Object {
+	text, err := formatArgs("printf", args)
+	if err != nil {
+		return err
+	}
+
+	io.WriteString(ctx.Stdout, text)
+	return NULL
+}
+
+// checks the format string argument of the builtin and formats the rest of the arguments
+func formatArgs(builtin string, args []object.Object) (string, *object.Error) {
+	if len(args) < 1 {
+		return "", newError("wrong number of arguments. got=%d, want=1 or more", len(args))
+	}
+
+	format, ok := args[0].(*object.String)
+	if !ok {
+		return "", newError("argument to `%s` must be STRING, got %s", builtin, args[0].Type())
+	}
+
+	return formatString(format.Value, args[1:])
+}
+
+// Flags allowed between the % and the width of a verb
+const formatFlags = "-+ 0#"
+
+// Verbs supported by formatString()
+const formatVerbs = "dsvqxft"
+
+// formats the arguments according to the verbs of the format string
+//
+//	%d	integer
+//	%s %v	any value, strings are written as they are
+//	%q	quoted string
+//	%x	hexadecimal integer or string
+//	%f	float or integer
+//	%t	boolean
+//	%%	a percent sign
+//
+// Verbs take flags (-+ 0#), a width and a precision as in Go: %-8s %08.3f
+func formatString(format string, args []object.Object) (string, *object.Error) {
+	var out strings.Builder
+	used := 0
+
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' {
+			out.WriteByte(format[i])
+			continue
+		}
+
+		// %[flags][width][.precision]verb
+		start := i
+		i++
+		for i < len(format) && strings.IndexByte(formatFlags, format[i]) >= 0 {
+			i++
+		}
+		for i < len(format) && isDigit(format[i]) {
+			i++
+		}
+		if i < len(format) && format[i] == '.' {
+			i++
+			for i < len(format) && isDigit(format[i]) {
+				i++
+			}
+		}
+
+		if i >= len(format) {
+			return "", newError("missing verb after %q at the end of the format", format[start:])
+		}
+
+		spec, verb := format[start:i], format[i]
+		if verb == '%' {
+			out.WriteByte('%')
+			continue
+		}
+
+		// keep counting the verbs when the arguments run out, for the error below
+		if used < len(args) {
+			text, err := formatVerb(spec, verb, args[used])
+			if err != nil {
+				return "", err
+			}
+			out.WriteString(text)
+		} else if strings.IndexByte(formatVerbs, verb) < 0 {
+			return "", unknownVerbError(verb)
+		}
+		used++
+	}
+
+	if used != len(args) {
+		return "", newError("format %q expects %d %s, got %d", format, used, pluralize("argument", used), len(args))
+	}
+
+	return out.String(), nil
+}
+
+// formats a single argument, spec holds the % with the flags, width and precision
+func formatVerb(spec string, verb byte, arg object.Object) (string, *object.Error) {
+	goFormat := spec + string(verb)
+
+	switch verb {
+	case 'd':
+		if integer, ok := arg.(*object.Integer); ok {
+			return fmt.Sprintf(goFormat, integer.Value), nil
+		}
+	case 's', 'v':
+		// the same text as print writes
+		return fmt.Sprintf(spec+"s", arg.Inspect()), nil
+	case 'q':
+		if str, ok := arg.(*object.String); ok {
+			return fmt.Sprintf(goFormat, str.Value), nil
+		}
+	case 'x':
+		switch arg := arg.(type) {
+		case *object.Integer:
+			return fmt.Sprintf(goFormat, arg.Value), nil
+		case *object.String:
+			return fmt.Sprintf(goFormat, arg.Value), nil
+		}
+	case 'f':
+		if isNumber(arg) {
+			return fmt.Sprintf(goFormat, toFloat(arg)), nil
+		}
+	case 't':
+		if boolean, ok := arg.(*object.Boolean); ok {
+			return fmt.Sprintf(goFormat, boolean.Value), nil
+		}
+	default:
+		return "", unknownVerbError(verb)
+	}
+
+	return "", newError("%%%c expects %s, got %s", verb, verbTypes[verb], arg.Type())
+}
+
+// returns the error for a verb not supported by formatString()
+func unknownVerbError(verb byte) *object.Error {
+	return newError("unknown verb %%%c in format", verb)
+}
+
+// Types of the arguments accepted by the verbs, used in errors
+var verbTypes = map[byte]string{
+	'd': "INTEGER",
+	'q': "STRING",
+	'x': "INTEGER or STRING",
+	'f': "FLOAT or INTEGER",
+	't': "BOOLEAN",
+}
+
+// returns true if the byte is a decimal digit
+func isDigit(char byte) bool {
+	return '0' <= char && char <= '9'
+}
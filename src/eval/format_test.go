@@ -0,0 +1,96 @@
+package eval
+
+import (
+	"testing"
+)
+
+func TestFormatFunction(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{`format("plain text")`, "plain text"},
+		{`format("%s is %d years old", "Ada", 36)`, "Ada is 36 years old"},
+		{`format("%d%%", 50)`, "50%"},
+		{`format("[%5d|%-5d|%05d|%+d]", 42, 42, 42, 42)`, "[   42|42   |00042|+42]"},
+		{`format("[%8s|%-8s|%.3s]", "total", "total", "abcdef")`, "[   total|total   |abc]"},
+		{`format("%f %.2f %8.3f", 1.5, 3.14159, 2)`, "1.500000 3.14    2.000"},
+		{`format("%x %x", 255, "hi")`, "ff 6869"},
+		{`format("%q %q", "hi", "")`, `"hi" ""`},
+		{`format("%q", "say \"hi\"\n")`, `"say \"hi\"\n"`},
+		{`format("%t %t", true, false)`, "true false"},
+		{`format("%v %v %v", 1, 2.5, "text")`, "1 2.5 text"},
+		// strings are quoted inside arrays and hashes
+		{`format("%v", [1, "a", [true]])`, `[1, "a", [true]]`},
+		{`format("%s", {"name": "x", 1: [2]})`, `{"name": "x", 1: [2]}`},
+		// functions are written as their signature
+		{`format("%v", func add(x, y) { x + y; })`, "func add(x, y)"},
+		{`format("%v", func(x) { x; })`, "func(x)"},
+		{`format("%s", len)`, "builtin function"},
+	}
+
+	for _, tt := range tests {
+		testStringObject(t, testEval(tt.input), tt.expected)
+	}
+}
+
+func TestFormatErrors(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{`format("%d", "x")`, "%d expects INTEGER, got STRING"},
+		{`format("%d", 1.5)`, "%d expects INTEGER, got FLOAT"},
+		{`format("%f", "x")`, "%f expects FLOAT or INTEGER, got STRING"},
+		{`format("%t", 1)`, "%t expects BOOLEAN, got INTEGER"},
+		{`format("%q", 1)`, "%q expects STRING, got INTEGER"},
+		{`format("%x", true)`, "%x expects INTEGER or STRING, got BOOLEAN"},
+		{`format("%d %d", 1)`, `format "%d %d" expects 2 arguments, got 1`},
+		{`format("%d", 1, 2)`, `format "%d" expects 1 argument, got 2`},
+		{`format("none", 1)`, `format "none" expects 0 arguments, got 1`},
+		{`format("%y", 1)`, "unknown verb %y in format"},
+		{`format("%d %y", 1)`, "unknown verb %y in format"},
+		{`format("100%")`, `missing verb after "%" at the end of the format`},
+		{`format("%-5")`, `missing verb after "%-5" at the end of the format`},
+		{`format(1)`, "argument to `format` must be STRING, got INTEGER"},
+		{`format()`, "wrong number of arguments. got=0, want=1 or more"},
+		{`printf("%d", "x")`, "%d expects INTEGER, got STRING"},
+	}
+
+	for _, tt := range tests {
+		testErrorMessage(t, testEval(tt.input), tt.expected)
+	}
+}
+
+func TestPrintfResultIsNull(t *testing.T) {
+	evaluated, stdout, _ := testEvalWithIO(`var r = printf("x"); printf("%v", r); r ?? 1`, "")
+	testIntegerObject(t, evaluated, 1)
+
+	if stdout != "xnull" {
+		t.Errorf("wrong stdout. got=%q", stdout)
+	}
+}
+
+func TestPrintfFunction(t *testing.T) {
+	evaluated, stdout, _ := testEvalWithIO(`printf("%s: %5.1f%%", "cpu", 12.34);`, "")
+	testNullObject(t, evaluated)
+
+	if stdout != "cpu:  12.3%" {
+		t.Errorf("wrong stdout. got=%q", stdout)
+	}
+}
+
+func TestPrintFormatAndInterpolationAgree(t *testing.T) {
+	input := `func f(x) { x; }
+var v = [f, "a", {"k": null}];
+println(v);
+printf("%v\n", v);
+println("${v}");`
+
+	_, stdout, _ := testEvalWithIO(input, "")
+
+	line := `[func f(x), "a", {"k": null}]` + "\n"
+	if stdout != line+line+line {
+		t.Errorf("wrong stdout. got=%q", stdout)
+	}
+}
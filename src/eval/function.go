@@ -3,9 +3,12 @@ package eval
 import (
 	"devscript/src/ast"
 	"devscript/src/object"
+	"devscript/src/token"
 )
 
-// evaluates a function expression
+// evaluates a function expression,
+// like function literals the function closes over the environment it is defined in.
+// The name is declared like a var, so it cannot replace a let or a const
 //
 //	func add(x, y) {
 //	  return x + y;
@@ -14,10 +17,12 @@ func evalFunctionExpression(node *ast.FunctionExpression, env *object.Environmen
 	name := node.Name
 	parameters := node.Parameters
 	body := node.Body
-	obj := &object.Function{Name: name, Parameters: parameters, Body: body}
+	obj := &object.Function{Name: name, Parameters: parameters, Env: env, Body: body}
 
 	// mapping function object to function name
-	env.Set(name.Value, obj)
+	if err := env.Declare(name.Value, obj, object.VAR_DECLARATION); err != nil {
+		return newError("%s", err)
+	}
 
 	return obj
 }
@@ -42,7 +47,7 @@ func evalCallExpression(node *ast.CallExpression, function object.Object, env *o
 		return args[0]
 	}
 
-	return applyFunction(function, args)
+	return applyFunction(env.Context(), function, args, node.Function.Pos())
 }
 
 // Evaluates a list of expressions.
@@ -71,27 +76,72 @@ func evalExpressions(expressions []ast.Expression, env *object.Environment) []ob
 	return result
 }
 
+// Maximum number of nested function calls,
+// deeper recursion is reported as an error instead of overflowing the Go stack
+const maxCallDepth = 10000
+
 // Applies a function to a list of arguments.
-// Takes function and argument list as arguments.
-func applyFunction(fn object.Object, args []object.Object) object.Object {
+// Takes the context of the caller, function, argument list and the position of the call as arguments.
+//
+// The call is pushed on the call stack of the context while the function runs,
+// a snapshot of the stack is attached to every error, see Eval()
+func applyFunction(ctx *object.Context, fn object.Object, args []object.Object, pos token.Position) object.Object {
 	switch fn := fn.(type) {
 	case *object.Function:
+		// Check the number of arguments
+		if len(args) != len(fn.Parameters) {
+			return newError("%s expects %d %s, got %d",
+				functionName(fn), len(fn.Parameters), pluralize("argument", len(fn.Parameters)), len(args))
+		}
+
+		if len(ctx.CallStack) >= maxCallDepth {
+			return newError("maximum call depth of %d exceeded in %s", maxCallDepth, functionName(fn))
+		}
+
+		ctx.CallStack = append(ctx.CallStack, object.Frame{Function: functionName(fn), Pos: pos})
+		defer func() { ctx.CallStack = ctx.CallStack[:len(ctx.CallStack)-1] }()
+
 		extendedEnv := extendFunctionEnv(fn, args)
-		// Evaluate the function body in the new environment
-		evaluated := Eval(fn.Body, extendedEnv)
+		// Evaluate the function body in the new environment,
+		// the body shares the scope of the parameters
+		evaluated := evalBlockStatement(fn.Body, extendedEnv)
 		return unwrapReturnValue(evaluated)
 
 	// If the function is a builtin function, call it.
 	case *object.Builtin:
-		return fn.Function(args...)
-		
+		return fn.Function(ctx, args...)
+
+	case nil:
+		return newError("not a function: no value")
+
 	default:
 		return newError("not a function: %s", fn.Type())
 	}
 }
 
+// returns the name of a named function, "function" for function literals
+func functionName(fn *object.Function) string {
+	if fn.Name != nil {
+		return fn.Name.Value
+	}
+	return "function"
+}
+
+// returns the plural of a word unless count is 1
+//
+//	pluralize("argument", 1)	// argument
+//	pluralize("argument", 2)	// arguments
+func pluralize(word string, count int) string {
+	if count == 1 {
+		return word
+	}
+	return word + "s"
+}
+
 // Creates a new environment for the function.
 // Sets the function parameters to the arguments.
+//
+// The number of arguments is checked by applyFunction()
 func extendFunctionEnv(fn *object.Function, args []object.Object) *object.Environment {
 	// Create a new environment with the function's environment as the outer environment
 	env := object.NewEnclosedEnvironment(fn.Env)
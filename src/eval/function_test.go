@@ -86,3 +86,65 @@ func TestFunctionApplication(t *testing.T) {
 		testIntegerObject(t, testEval(tt.input), tt.expected)
 	}
 }
+
+func TestNamedFunctionClosures(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected int64
+	}{
+		// globals are visible inside named functions
+		{`
+		var base = 10;
+		func addBase(x) { x + base; }
+		addBase(5);
+		`, 15},
+		// other top-level functions are visible
+		{`
+		func double(x) { x * 2; }
+		func quadruple(x) { double(double(x)); }
+		quadruple(3);
+		`, 12},
+		// nested named functions see the locals of the enclosing function
+		{`
+		func outer(x) {
+			var y = 2;
+			func inner(z) { x * y + z; }
+			inner(1);
+		}
+		outer(5);
+		`, 11},
+		// a returned named function keeps its defining environment
+		{`
+		func makeAdder(x) {
+			func adder(y) { x + y; }
+			return adder;
+		}
+		var addTwo = makeAdder(2);
+		var addTen = makeAdder(10);
+		addTwo(1) + addTen(1);
+		`, 14},
+		// nested named functions can be recursive
+		{`
+		func sumTo(n) {
+			func go(i, acc) {
+				if (i > n) { return acc; }
+				go(i + 1, acc + i);
+			}
+			go(1, 0);
+		}
+		sumTo(10);
+		`, 55},
+		// mutual recursion between top-level functions
+		{`
+		func isEven(n) { if (n == 0) { true } else { isOdd(n - 1) } }
+		func isOdd(n) { if (n == 0) { false } else { isEven(n - 1) } }
+		var count = 0;
+		for (i in range(10)) { if (isEven(i)) { count = count + 1; } }
+		if (isOdd(7)) { count } else { -1 }
+		`, 5},
+	}
+
+	for _, tt := range tests {
+		testIntegerObject(t, testEval(tt.input), tt.expected)
+	}
+}
@@ -0,0 +1,36 @@
+package eval
+
+import (
+	"devscript/src/ast"
+	"devscript/src/object"
+)
+
+// evaluates a hash literal.
+// Keys and values are evaluated in source order.
+//
+//	{"name": "x", 1: true};
+func evalHashLiteral(node *ast.HashLiteral, env *object.Environment) object.Object {
+	hash := object.NewHash()
+
+	for _, pair := range node.Pairs {
+		key := Eval(pair.Key, env)
+		if isError(key) {
+			return key
+		}
+
+		// Only Integer, String & Boolean objects can be used as keys
+		hashKey, ok := key.(object.Hashable)
+		if !ok {
+			return newError("unusable as hash key: %s", key.Type())
+		}
+
+		value := Eval(pair.Value, env)
+		if isError(value) {
+			return value
+		}
+
+		hash.Set(hashKey, object.HashPair{Key: key, Value: value})
+	}
+
+	return hash
+}
@@ -50,3 +50,19 @@ func isTruthy(condition object.Object) bool {
 		return true
 	}
 }
+
+// Evaluate Conditional Expressions,
+// only the chosen branch is evaluated
+//
+//	condition ? consequence : alternative
+func evalConditionalExpression(node *ast.ConditionalExpression, env *object.Environment) object.Object {
+	condition := Eval(node.Condition, env)
+	if isError(condition) {
+		return condition
+	}
+
+	if isTruthy(condition) {
+		return Eval(node.Consequence, env)
+	}
+	return Eval(node.Alternative, env)
+}
@@ -28,3 +28,56 @@ func TestIfElseExpressions(t *testing.T) {
 		}
 	}
 }
+
+// Only null, false and the integer literal 0 are falsy,
+// an integer computed at runtime is truthy even when it is 0
+func TestTruthiness(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected bool
+	}{
+		{"if (null) { true } else { false }", false},
+		{"if (0) { true } else { false }", false},
+		{"if (1 - 1) { true } else { false }", true},
+		{`if ("") { true } else { false }`, true},
+		{"if ([]) { true } else { false }", true},
+		{"!null", true},
+		{"!0", true},
+		{"!(1 - 1)", false},
+		{"!!0", false},
+		{"var i = 0; while (i) { i = i + 1; } i == 0", true},
+		{"0 ? false : true", true},
+	}
+
+	for _, tt := range tests {
+		testBooleanObject(t, testEval(tt.input), tt.expected)
+	}
+}
+
+func TestConditionalExpressions(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{"true ? 10 : 20", 10},
+		{"false ? 10 : 20", 20},
+		{"1 < 2 ? 10 : 20", 10},
+		{"0 ? 10 : 20", 20},
+		{"var n = 0; n > 0 ? 1 : n < 0 ? -1 : 0", 0},
+		{"var n = -4; n > 0 ? 1 : n < 0 ? -1 : 0", -1},
+		{"true ? null : 1", nil},
+		// only the chosen branch is evaluated
+		{"true ? 1 : undefinedVariable", 1},
+		{"var x = 0; x == 0 ? 0 : 10 / x", 0},
+	}
+
+	for _, tt := range tests {
+		evaluated := testEval(tt.input)
+		integer, ok := tt.expected.(int)
+		if ok {
+			testIntegerObject(t, evaluated, int64(integer))
+		} else {
+			testNullObject(t, evaluated)
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package eval
+
+import "devscript/src/object"
+
+// evaluates an index expression
+//
+//	[1, 2, 3][0];	// 1
+//	"héllo"[1];	// "é"
+func evalIndexExpression(left, index object.Object) object.Object {
+	switch {
+	case left.Type() == object.ARRAY_OBJ && index.Type() == object.INTEGER_OBJ:
+		return evalArrayIndexExpression(left, index)
+	case left.Type() == object.STRING_OBJ && index.Type() == object.INTEGER_OBJ:
+		return evalStringIndexExpression(left, index)
+	case left.Type() == object.HASH_OBJ:
+		return evalHashIndexExpression(left, index)
+	default:
+		return newError("index operator not supported: %s", left.Type())
+	}
+}
+
+// evaluates an array index expression
+//
+// Out of range indexes evaluate to NULL
+//
+//	[1, 2, 3][1];	// 2
+//	[1, 2, 3][3];	// null
+//	[1, 2, 3][-1];	// null
+func evalArrayIndexExpression(array, index object.Object) object.Object {
+	elements := array.(*object.Array).Elements
+	idx := index.(*object.Integer).Value
+	max := int64(len(elements) - 1)
+
+	if idx < 0 || idx > max {
+		return NULL
+	}
+
+	return elements[idx]
+}
+
+// evaluates a string index expression,
+// strings are indexed by code point and not by byte
+//
+// Out of range indexes evaluate to NULL
+//
+//	"héllo"[1];	// "é"
+//	"héllo"[5];	// null
+func evalStringIndexExpression(str, index object.Object) object.Object {
+	idx := index.(*object.Integer).Value
+	if idx < 0 {
+		return NULL
+	}
+
+	// counts code points up to the index without copying the string
+	var i int64
+	for _, r := range str.(*object.String).Value {
+		if i == idx {
+			return &object.String{Value: string(r)}
+		}
+		i++
+	}
+
+	return NULL
+}
+
+// evaluates a hash index expression
+//
+// Missing keys evaluate to NULL
+//
+//	{"a": 1}["a"];	// 1
+//	{"a": 1}["b"];	// null
+func evalHashIndexExpression(hash, index object.Object) object.Object {
+	key, ok := index.(object.Hashable)
+	if !ok {
+		return newError("unusable as hash key: %s", index.Type())
+	}
+
+	pair, ok := hash.(*object.Hash).Get(key)
+	if !ok {
+		return NULL
+	}
+
+	return pair.Value
+}
@@ -1,6 +1,9 @@
 package eval
 
-import "devscript/src/object"
+import (
+	"devscript/src/object"
+	"math"
+)
 
 // Evaluates an infix expression
 //
@@ -13,6 +16,13 @@ func evalInfixExpression(operator string, left, right object.Object) object.Obje
 			return evalIntegerInfixExpression(operator, left, right)
 		}
 
+	// if either object is a float and the other is a number,
+	// promote both to float and evaluate the infix expression
+	case isNumber(left) && isNumber(right):
+		{
+			return evalFloatInfixExpression(operator, left, right)
+		}
+
 	// if both objects are strings, evaluate the infix expression
 	case left.Type() == object.STRING_OBJ && right.Type() == object.STRING_OBJ:
 		{
@@ -44,8 +54,18 @@ func evalInfixExpression(operator string, left, right object.Object) object.Obje
 //	5 - 5;		// 0
 //	5 * 5;		// 25
 //	5 / 5;		// 1
+//	7 / 2;		// 3.5, the result is a float when the division is not exact
+//	7 % 5;		// 2
+//	2 ** 3;		// 8
+//	6 & 3;		// 2
+//	6 | 3;		// 7
+//	6 ^ 3;		// 5
+//	1 << 3;		// 8
+//	8 >> 1;		// 4
 //	5 < 5;		// false
 //	5 > 5;		// false
+//	5 <= 5;		// true
+//	5 >= 5;		// true
 //	5 == 5;		// true
 //	5 != 5;		// false
 func evalIntegerInfixExpression(operator string, left, right object.Object) object.Object {
@@ -60,11 +80,90 @@ func evalIntegerInfixExpression(operator string, left, right object.Object) obje
 	case "*":
 		return &object.Integer{Value: leftVal * rightVal}
 	case "/":
+		if rightVal == 0 {
+			return newError("division by zero")
+		}
+		if leftVal%rightVal != 0 {
+			return &object.Float{Value: float64(leftVal) / float64(rightVal)}
+		}
 		return &object.Integer{Value: leftVal / rightVal}
+	case "%":
+		if rightVal == 0 {
+			return newError("modulo by zero")
+		}
+		return &object.Integer{Value: leftVal % rightVal}
+	case "**":
+		return integerPower(leftVal, rightVal)
+	case "&":
+		return &object.Integer{Value: leftVal & rightVal}
+	case "|":
+		return &object.Integer{Value: leftVal | rightVal}
+	case "^":
+		return &object.Integer{Value: leftVal ^ rightVal}
+	case "<<", ">>":
+		if rightVal < 0 {
+			return newError("negative shift count: %d", rightVal)
+		}
+		if operator == "<<" {
+			return &object.Integer{Value: leftVal << uint64(rightVal)}
+		}
+		return &object.Integer{Value: leftVal >> uint64(rightVal)}
+	case "<":
+		return nativeBoolToBooleanObject(leftVal < rightVal)
+	case ">":
+		return nativeBoolToBooleanObject(leftVal > rightVal)
+	case "<=":
+		return nativeBoolToBooleanObject(leftVal <= rightVal)
+	case ">=":
+		return nativeBoolToBooleanObject(leftVal >= rightVal)
+	case "==":
+		return nativeBoolToBooleanObject(leftVal == rightVal)
+	case "!=":
+		return nativeBoolToBooleanObject(leftVal != rightVal)
+	default:
+		return newError("unknown operator: %s %s %s", left.Type(), operator, right.Type())
+	}
+}
+
+// evaluates a float infix expression,
+// Integer operands are promoted to Float
+//
+//	7 / 2.0;	// 3.5
+//	0.5 + 1;	// 1.5
+//	1.5 < 2;	// true
+//	5.5 % 2;	// 1.5
+//	2 ** 0.5;	// 1.4142135623730951
+func evalFloatInfixExpression(operator string, left, right object.Object) object.Object {
+	leftVal := toFloat(left)
+	rightVal := toFloat(right)
+
+	switch operator {
+	case "+":
+		return &object.Float{Value: leftVal + rightVal}
+	case "-":
+		return &object.Float{Value: leftVal - rightVal}
+	case "*":
+		return &object.Float{Value: leftVal * rightVal}
+	case "/":
+		if rightVal == 0 {
+			return newError("division by zero")
+		}
+		return &object.Float{Value: leftVal / rightVal}
+	case "%":
+		if rightVal == 0 {
+			return newError("modulo by zero")
+		}
+		return &object.Float{Value: math.Mod(leftVal, rightVal)}
+	case "**":
+		return &object.Float{Value: math.Pow(leftVal, rightVal)}
 	case "<":
 		return nativeBoolToBooleanObject(leftVal < rightVal)
 	case ">":
 		return nativeBoolToBooleanObject(leftVal > rightVal)
+	case "<=":
+		return nativeBoolToBooleanObject(leftVal <= rightVal)
+	case ">=":
+		return nativeBoolToBooleanObject(leftVal >= rightVal)
 	case "==":
 		return nativeBoolToBooleanObject(leftVal == rightVal)
 	case "!=":
@@ -74,6 +173,46 @@ func evalIntegerInfixExpression(operator string, left, right object.Object) obje
 	}
 }
 
+// raises an integer to an integer power.
+// Negative exponents give a Float, since the result is a fraction.
+//
+//	2 ** 10;	// 1024
+//	2 ** -1;	// 0.5
+func integerPower(base, exponent int64) object.Object {
+	if exponent < 0 {
+		return &object.Float{Value: math.Pow(float64(base), float64(exponent))}
+	}
+
+	// exponentiation by squaring
+	result := int64(1)
+	for exponent > 0 {
+		if exponent&1 == 1 {
+			result *= base
+		}
+		base *= base
+		exponent >>= 1
+	}
+
+	return &object.Integer{Value: result}
+}
+
+// returns true if the object is an Integer or a Float
+func isNumber(obj object.Object) bool {
+	return obj.Type() == object.INTEGER_OBJ || obj.Type() == object.FLOAT_OBJ
+}
+
+// converts an Integer or Float object to a native float64
+func toFloat(obj object.Object) float64 {
+	switch obj := obj.(type) {
+	case *object.Integer:
+		return float64(obj.Value)
+	case *object.Float:
+		return obj.Value
+	default:
+		return 0
+	}
+}
+
 // evaluates a string infix expression
 //
 //	"Hello" + "World";		// "HelloWorld"
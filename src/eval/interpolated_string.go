@@ -0,0 +1,25 @@
+package eval
+
+import (
+	"devscript/src/ast"
+	"devscript/src/object"
+	"strings"
+)
+
+// evaluates a string with embedded expressions,
+// the values are written in the same form as by print
+//
+//	"${name} has ${len(items)} items: ${items}";	// "bob has 2 items: [1, "two"]"
+func evalInterpolatedString(node *ast.InterpolatedString, env *object.Environment) object.Object {
+	var out strings.Builder
+
+	for _, part := range node.Parts {
+		value := Eval(part, env)
+		if isError(value) {
+			return value
+		}
+		out.WriteString(value.Inspect())
+	}
+
+	return &object.String{Value: out.String()}
+}
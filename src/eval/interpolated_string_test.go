@@ -0,0 +1,32 @@
+package eval
+
+import (
+	"testing"
+)
+
+func TestInterpolatedString(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{`var name = "Ada"; "Hello ${name}!"`, "Hello Ada!"},
+		{`var count = 2; "you have ${count + 1} items"`, "you have 3 items"},
+		{`var user = {"name": "Ada"}; "Hello ${user.name}"`, "Hello Ada"},
+		{`"${1.5} ${true} ${[1, "two"]} ${ {"a": "b"} }"`, `1.5 true [1, "two"] {"a": "b"}`},
+		{`var f = func add(a, b) { a + b }; "${f}"`, "func add(a, b)"},
+		{`"${println}"`, "builtin function"},
+		{`var x = "in"; "out ${"${x}ner"}"`, "out inner"},
+		{`"\${not} $interpolated"`, "${not} $interpolated"},
+		{`"${"a"}${"b"}"`, "ab"},
+	}
+
+	for _, tt := range tests {
+		evaluated := testEval(tt.input)
+		testStringObject(t, evaluated, tt.expected)
+	}
+}
+
+func TestInterpolatedStringError(t *testing.T) {
+	evaluated := testEval(`"value: ${1 / 0}"`)
+	testErrorMessage(t, evaluated, "division by zero")
+}
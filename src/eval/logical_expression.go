@@ -0,0 +1,45 @@
+package eval
+
+import (
+	"devscript/src/ast"
+	"devscript/src/object"
+)
+
+// evaluates a logical expression with short-circuit evaluation.
+// The operand that decides the result is returned, not a Boolean.
+//
+//	x != 0 && 10 / x > 1;	// 10 / x is not evaluated when x is 0
+//	0 && 5;			// 0
+//	1 && 5;			// 5
+//	0 || 5;			// 5
+//	1 || 5;			// 1
+//	null ?? 5;		// 5
+//	0 ?? 5;			// 0, only null is replaced
+func evalLogicalExpression(node *ast.InfixExpression, env *object.Environment) object.Object {
+	left := Eval(node.Left, env)
+	if isError(left) {
+		return left
+	}
+
+	switch node.Operator {
+	case "&&":
+		// a falsy left operand decides the result
+		if !isTruthy(left) {
+			return left
+		}
+	case "||":
+		// a truthy left operand decides the result
+		if isTruthy(left) {
+			return left
+		}
+	case "??":
+		// any left operand except null decides the result
+		if left != nil && left != NULL {
+			return left
+		}
+	default:
+		return newError("unknown operator: %s %s", left.Type(), node.Operator)
+	}
+
+	return Eval(node.Right, env)
+}
@@ -0,0 +1,74 @@
+package eval
+
+import "testing"
+
+func TestLogicalExpressions(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{"true && true", true},
+		{"true && false", false},
+		{"false && true", false},
+		{"false || true", true},
+		{"false || false", false},
+		{"true || false", true},
+		// the deciding operand is returned
+		{"1 && 5", 5},
+		{"0 && 5", 0},
+		{"0 || 5", 5},
+		{"3 || 5", 3},
+		{`"" || "default"`, ""},
+		{"1 < 2 && 2 < 3", true},
+		{"1 > 2 || 2 > 3", false},
+		// ?? only replaces null
+		{`null ?? "default"`, "default"},
+		{`var x = null; x ?? 5`, 5},
+		{`{"a": 1}["b"] ?? "missing"`, "missing"},
+		{"0 ?? 5", 0},
+		{`"" ?? "default"`, ""},
+		{"false ?? true", false},
+		{"null ?? null ?? 3", 3},
+	}
+
+	for _, tt := range tests {
+		evaluated := testEval(tt.input)
+		switch expected := tt.expected.(type) {
+		case bool:
+			testBooleanObject(t, evaluated, expected)
+		case int:
+			testIntegerObject(t, evaluated, int64(expected))
+		case string:
+			testStringObject(t, evaluated, expected)
+		}
+	}
+}
+
+func TestLogicalExpressionsShortCircuit(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected bool
+	}{
+		// the division by zero is never evaluated
+		{"var x = 0; x != 0 && 10 / x > 1", false},
+		// the unknown identifier is never evaluated
+		{"true || undefinedVariable", true},
+		{"false && undefinedVariable", false},
+		{"true ?? undefinedVariable", true},
+	}
+
+	for _, tt := range tests {
+		testBooleanObject(t, testEval(tt.input), tt.expected)
+	}
+
+	// the right operand is not evaluated when the left one decides
+	input := `
+	var calls = 0;
+	false && (calls = calls + 1);
+	true || (calls = calls + 1);
+	true && (calls = calls + 1);
+	false || (calls = calls + 1);
+	calls;
+	`
+	testIntegerObject(t, testEval(input), 2)
+}
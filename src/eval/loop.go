@@ -0,0 +1,152 @@
+package eval
+
+import (
+	"devscript/src/ast"
+	"devscript/src/object"
+)
+
+// Evaluate While Statements
+//
+//	while (condition) {
+//	    body
+//	}
+func evalWhileStatement(node *ast.WhileStatement, env *object.Environment) object.Object {
+	for {
+		// Evaluate the condition
+		condition := Eval(node.Condition, env)
+		if isError(condition) {
+			return condition
+		}
+
+		if !isTruthy(condition) {
+			return NULL
+		}
+
+		result := Eval(node.Body, env)
+		if stop, value := loopControl(result); stop {
+			return value
+		}
+	}
+}
+
+// Evaluate For Statements
+//
+// A let in the init statement is only visible inside the loop
+//
+//	for (init; condition; post) {
+//	    body
+//	}
+func evalForStatement(node *ast.ForStatement, env *object.Environment) object.Object {
+	env = object.NewBlockEnvironment(env)
+
+	// Evaluate the init statement once
+	if node.Init != nil {
+		init := Eval(node.Init, env)
+		if isError(init) {
+			return init
+		}
+	}
+
+	for {
+		// A missing condition loops until break or return
+		if node.Condition != nil {
+			condition := Eval(node.Condition, env)
+			if isError(condition) {
+				return condition
+			}
+
+			if !isTruthy(condition) {
+				return NULL
+			}
+		}
+
+		result := Eval(node.Body, env)
+		if stop, value := loopControl(result); stop {
+			return value
+		}
+
+		// Evaluate the post expression after every iteration,
+		// including the ones ended by continue
+		if node.Post != nil {
+			post := Eval(node.Post, env)
+			if isError(post) {
+				return post
+			}
+		}
+	}
+}
+
+// Evaluate For-In Statements
+//
+//	for (value in iterable) {
+//	    body
+//	}
+//
+//	for (key, value in iterable) {
+//	    body
+//	}
+//
+// With a single loop variable, hashes bind the key and
+// every other iterable binds the value.
+// The loop variables are bound in a new scope for every iteration,
+// so closures created in the body keep the values of their iteration.
+func evalForInStatement(node *ast.ForInStatement, env *object.Environment) object.Object {
+	obj := Eval(node.Iterable, env)
+	if isError(obj) {
+		return obj
+	}
+
+	iterable, ok := obj.(object.Iterable)
+	if !ok {
+		return newError("%s is not iterable", obj.Type())
+	}
+
+	_, isHash := obj.(*object.Hash)
+	iterator := iterable.Iterator()
+
+	for {
+		key, value, ok := iterator.Next()
+		if !ok {
+			return NULL
+		}
+
+		iterationEnv := object.NewBlockEnvironment(env)
+		switch {
+		case node.Key != nil:
+			iterationEnv.Set(node.Key.Value, key)
+			iterationEnv.Set(node.Value.Value, value)
+		case isHash:
+			iterationEnv.Set(node.Value.Value, key)
+		default:
+			iterationEnv.Set(node.Value.Value, value)
+		}
+
+		result := Eval(node.Body, iterationEnv)
+		if stop, value := loopControl(result); stop {
+			return value
+		}
+	}
+}
+
+// Decides what a loop does with the result of its body.
+//
+// Returns true and the value of the loop when the loop has to stop:
+//
+//	break		// stops the loop, the loop evaluates to NULL
+//	return, error	// stops the loop and is passed on to the enclosing block
+//
+// Returns false for continue and normal results, the loop moves on to the next iteration.
+func loopControl(result object.Object) (bool, object.Object) {
+	if result == nil {
+		return false, nil
+	}
+
+	switch result.Type() {
+	case object.BREAK_OBJ:
+		return true, NULL
+	case object.RETURN_VALUE_OBJ, object.ERROR_OBJ, object.EXIT_OBJ:
+		return true, result
+	default:
+		return false, nil
+	}
+}
@@ -0,0 +1,129 @@
+package eval
+
+import (
+	"devscript/src/object"
+	"testing"
+)
+
+func TestWhileStatements(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected int64
+	}{
+		{"var i = 0; while (i < 10) { i = i + 1; } i;", 10},
+		{"var i = 0; while (false) { i = i + 1; } i;", 0},
+		{"var i = 5; while (i > 0) { i = i - 1; } i;", 0},
+		{"var i = 0; while (true) { i = i + 1; if (i == 3) { break; } } i;", 3},
+		{`
+		var i = 0;
+		var sum = 0;
+		while (i < 10) {
+			i = i + 1;
+			if (i > 5) { continue; }
+			sum = sum + i;
+		}
+		sum;
+		`, 15},
+		// A large number of iterations must not grow the Go stack
+		{"var i = 0; while (i < 100000) { i = i + 1; } i;", 100000},
+	}
+
+	for _, tt := range tests {
+		testIntegerObject(t, testEval(tt.input), tt.expected)
+	}
+}
+
+func TestForStatements(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected int64
+	}{
+		{"var sum = 0; for (var i = 0; i < 5; i = i + 1) { sum = sum + i; } sum;", 10},
+		{"var sum = 0; for (var i = 0; i < 10; i = i + 1) { if (i == 2) { continue; } if (i == 5) { break; } sum = sum + i; } sum;", 8},
+		{"var i = 0; for (;;) { i = i + 1; if (i == 7) { break; } } i;", 7},
+		{"var n = 0; for (var i = 0; i < 3; i = i + 1) { for (var j = 0; j < 3; j = j + 1) { if (j == 1) { break; } n = n + 1; } } n;", 3},
+	}
+
+	for _, tt := range tests {
+		testIntegerObject(t, testEval(tt.input), tt.expected)
+	}
+}
+
+func TestReturnInsideLoop(t *testing.T) {
+	input := `
+	var find = func(n) {
+		for (var i = 0; i < 100; i = i + 1) {
+			if (i * i == n) {
+				return i;
+			}
+		}
+		return -1;
+	};
+	find(49);
+	`
+
+	testIntegerObject(t, testEval(input), 7)
+}
+
+func TestLoopEvaluatesToNull(t *testing.T) {
+	testNullObject(t, testEval("while (false) {}"))
+	testNullObject(t, testEval("for (;;) { break; }"))
+}
+
+func TestForInStatements(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{"var sum = 0; for (x in [1, 2, 3]) { sum = sum + x; } sum;", 6},
+		{"var sum = 0; for (i, x in [10, 20, 30]) { sum = sum + i; } sum;", 3},
+		{`var s = ""; for (k in {"a": 1, "b": 2}) { s = s + k; } s;`, "ab"},
+		{`var sum = 0; for (k, v in {"a": 1, "b": 2}) { sum = sum + v; } sum;`, 3},
+		{`var s = ""; for (c in "héllo") { s = c + s; } s;`, "olléh"},
+		{`var n = 0; for (i, c in "日本語") { n = i; } n;`, 2},
+		{"var sum = 0; for (i in range(5)) { sum = sum + i; } sum;", 10},
+		{"var sum = 0; for (i in range(2, 5)) { sum = sum + i; } sum;", 9},
+		{"var sum = 0; for (i in range(10, 0, -3)) { sum = sum + i; } sum;", 22},
+		{"var n = 0; for (i in range(5, 0)) { n = n + 1; } n;", 0},
+		{"var last = 0; for (i in range(1000000)) { last = i; if (i == 10) { break; } } last;", 10},
+		// stepping past the largest or smallest integer ends the range
+		{"var n = 0; for (i in range(9223372036854775800, 9223372036854775807, 10)) { n = n + 1; } n;", 1},
+		{"var last = 0; for (i in range(9223372036854775800, 9223372036854775807, 3)) { last = i; } last;", 9223372036854775806},
+		{"var n = 0; for (i in range(-9223372036854775800, -9223372036854775807 - 1, -5)) { n = n + 1; } n;", 2},
+		{"var sum = 0; for (x in [1, 2, 3, 4]) { if (x == 2) { continue; } sum = sum + x; } sum;", 8},
+	}
+
+	for _, tt := range tests {
+		evaluated := testEval(tt.input)
+		switch expected := tt.expected.(type) {
+		case int:
+			testIntegerObject(t, evaluated, int64(expected))
+		case string:
+			testStringObject(t, evaluated, expected)
+		}
+	}
+}
+
+func TestForInErrors(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"for (x in 5) { }", "INTEGER is not iterable"},
+		{"range(0, 10, 0)", "range step must not be zero"},
+		{`range("a")`, "argument to `range` must be INTEGER, got STRING"},
+		{"range()", "wrong number of arguments. got=0, want=1 to 3"},
+	}
+
+	for _, tt := range tests {
+		evaluated := testEval(tt.input)
+		errObj, ok := evaluated.(*object.Error)
+		if !ok {
+			t.Errorf("no error object returned. got=%T (%+v)", evaluated, evaluated)
+			continue
+		}
+		if errObj.Message != tt.expected {
+			t.Errorf("wrong error message. expected=%q, got=%q", tt.expected, errObj.Message)
+		}
+	}
+}
@@ -0,0 +1,50 @@
+package eval
+
+import (
+	"devscript/src/object"
+)
+
+// evaluates a member expression
+//
+//	e.message;		// message of a caught error
+//	{"name": "x"}.name;	// "x", same as ["name"]
+func evalMemberExpression(left object.Object, property string) object.Object {
+	switch left := left.(type) {
+	case *object.Exception:
+		return evalExceptionMember(left, property)
+	case *object.Hash:
+		return evalHashIndexExpression(left, &object.String{Value: property})
+	default:
+		return newError("property access not supported: %s", left.Type())
+	}
+}
+
+// evaluates a property of a caught error
+//
+//	e.message;	// "division by zero"
+//	e.type;		// "RuntimeError"
+//	e.value;	// value passed to throw, null for runtime errors
+//	e.stack;	// ["<main> (main.ds:9:1)", "divide (main.ds:2:12)"]
+func evalExceptionMember(exception *object.Exception, property string) object.Object {
+	err := exception.Error
+
+	switch property {
+	case "message":
+		return &object.String{Value: err.Message}
+	case "type":
+		return &object.String{Value: err.Kind}
+	case "value":
+		if err.Value == nil {
+			return NULL
+		}
+		return err.Value
+	case "stack":
+		stack := []object.Object{}
+		for _, frame := range err.Traceback() {
+			stack = append(stack, &object.String{Value: frame.String()})
+		}
+		return &object.Array{Elements: stack}
+	default:
+		return newError("unknown property %s of %s", property, exception.Type())
+	}
+}
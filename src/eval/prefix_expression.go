@@ -7,39 +7,46 @@ import "devscript/src/object"
 //	!true;		// false
 //	!false;		// true
 //	-5;		// -5
+//	-1.5;		// -1.5
+//	~5;		// -6
 func evalPrefixExpression(operator string, right object.Object) object.Object {
 	switch operator {
 	case "!":
 		return evalBangOperatorExpression(right)
 	case "-":
 		return evalMinusPrefixOperatorExpression(right)
+	case "~":
+		return evalBitwiseNotOperatorExpression(right)
 	default:
 		return newError("unknown operator: %s%s", operator, right.Type())
 	}
 }
 
 // evaluates a bang operator expression
+//
+//	!x is true when x is not truthy
 func evalBangOperatorExpression(right object.Object) object.Object {
-	switch right {
-	case TRUE:
-		return FALSE
-	case FALSE:
-		return TRUE
-	case NULL:
-		return TRUE
-	case ZERO:
-		return TRUE
-	default:
-		return FALSE
-	}
+	return nativeBoolToBooleanObject(!isTruthy(right))
 }
 
 // evaluates a minus prefix operator expression
 func evalMinusPrefixOperatorExpression(right object.Object) object.Object {
-	if right.Type() != object.INTEGER_OBJ {
+	switch right := right.(type) {
+	case *object.Integer:
+		return &object.Integer{Value: -right.Value}
+	case *object.Float:
+		return &object.Float{Value: -right.Value}
+	default:
 		return newError("unknown operator: -%s", right.Type())
 	}
+}
+
+// evaluates a bitwise not operator expression, only integers are supported
+func evalBitwiseNotOperatorExpression(right object.Object) object.Object {
+	integer, ok := right.(*object.Integer)
+	if !ok {
+		return newError("unknown operator: ~%s", right.Type())
+	}
 
-	value := right.(*object.Integer).Value
-	return &object.Integer{Value: -value}
+	return &object.Integer{Value: ^integer.Value}
 }
@@ -23,10 +23,11 @@ func evalBlockStatement(block *ast.BlockStatement, env *object.Environment) obje
 			// Get the type of the result
 			resultType := result.Type()
 
-			// If the result is a return value or an error, return it
+			// If the result is a return value, an error, an exit, break or continue, return it
 			// This is because we don't want to evaluate the rest of the statements
-			// after a return statement or an error
-			if resultType == object.RETURN_VALUE_OBJ || resultType == object.ERROR_OBJ {
+			// after a return, break or continue statement, an error or an exit
+			switch resultType {
+			case object.RETURN_VALUE_OBJ, object.ERROR_OBJ, object.EXIT_OBJ, object.BREAK_OBJ, object.CONTINUE_OBJ:
 				return result
 			}
 		}
@@ -44,3 +44,64 @@ func TestReturnStatements(t *testing.T) {
 		testIntegerObject(t, evaluated, tt.expected)
 	}
 }
+
+func TestScopes(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected int64
+	}{
+		// assignment updates the scope the variable is declared in
+		{"var count = 0; var inc = func() { count = count + 1; }; inc(); inc(); count;", 2},
+		{"func counter() { var n = 0; return func() { n = n + 1; n }; } var next = counter(); next(); next();", 2},
+		{"var x = 1; if (true) { x = 2; } x;", 2},
+		{"var total = 0; for (i in range(4)) { total = total + i; } total;", 6},
+		// var is scoped to the enclosing function, let and const to the enclosing block
+		{"if (true) { var x = 5; } x;", 5},
+		{"var x = 1; { let x = 2; x = 3; } x;", 1},
+		{"let x = 1; { const x = 2; } x;", 1},
+		{"func f() { { var inner = 7; } return inner; } f();", 7},
+		{"var sum = 0; for (let i = 0; i < 3; i = i + 1) { let double = i * 2; sum = sum + double; } sum;", 6},
+		// var can be redeclared, also by a named function
+		{"var x = 1; var x = 2; x;", 2},
+		{"var f = 1; func f() { 2 } f();", 2},
+		{"func f() { 1 } func f() { 2 } f();", 2},
+		// closures keep the loop variable of their iteration
+		{"var f = 0; for (i in range(3)) { if (i == 1) { f = func() { i }; } } f();", 1},
+	}
+
+	for _, tt := range tests {
+		testIntegerObject(t, testEval(tt.input), tt.expected)
+	}
+}
+
+func TestScopeErrors(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"const x = 1; x = 2;", "cannot assign to constant: x"},
+		{"const x = 1; func f() { x = 2; } f();", "cannot assign to constant: x"},
+		{"let x = 1; let x = 2;", "identifier already declared: x"},
+		{"var x = 1; let x = 2;", "identifier already declared: x"},
+		{"let x = 1; var x = 2;", "identifier already declared: x"},
+		{"const x = 1; const x = 2;", "identifier already declared: x"},
+		{"func f(a) { let a = 2; } f(1);", "identifier already declared: a"},
+		{"y = 1;", "identifier not found: y"},
+		{"if (true) { let x = 5; } x;", "identifier not found: x"},
+		{"for (let i = 0; i < 1; i = i + 1) {} i;", "identifier not found: i"},
+		{"for (v in [1]) {} v;", "identifier not found: v"},
+		{"try { throw 1; } catch (e) {} e;", "identifier not found: e"},
+		// named functions follow the same rules as var
+		{"const c = 1; func c() { return 2; } c();", "identifier already declared: c"},
+		{"let f = 1; func f() { return 2; }", "identifier already declared: f"},
+		{"func g() { 1 } let g = 2;", "identifier already declared: g"},
+		// a var can't hide a let of the blocks it is declared in
+		{"{ let x = 1; var x = 2; println(x); } println(x);", "identifier already declared: x"},
+		{"for (let i = 0; i < 1; i++) { var i = 10; }", "identifier already declared: i"},
+		{"{ const k = 1; { var k = 2; } }", "identifier already declared: k"},
+	}
+
+	for _, tt := range tests {
+		testErrorMessage(t, testEval(tt.input), tt.expected)
+	}
+}
@@ -0,0 +1,66 @@
+package eval
+
+import (
+	"devscript/src/ast"
+	"devscript/src/object"
+)
+
+// evaluates a throw statement
+//
+// The thrown value becomes the message of the error,
+// throwing a caught error raises it again with its original position and stack.
+//
+//	throw "invalid input";	// Error: invalid input
+//	catch (e) { throw e; }	// rethrows e
+func evalThrowStatement(node *ast.ThrowStatement, env *object.Environment) object.Object {
+	val := Eval(node.Value, env)
+	if isError(val) {
+		return val
+	}
+
+	if exception, ok := val.(*object.Exception); ok {
+		return exception.Error
+	}
+
+	message := val.Inspect()
+	if str, ok := val.(*object.String); ok {
+		message = str.Value
+	}
+
+	err := newError("%s", message)
+	err.Kind = object.THROWN_ERROR
+	err.Value = val
+
+	return err
+}
+
+// evaluates a try statement
+//
+// An error raised in the try block, by a builtin or a user function,
+// is bound to the catch parameter and the catch block is evaluated.
+// A call to exit is not caught.
+// The finally block always runs, a return, break, continue, error or exit
+// inside it replaces the result of the try and catch blocks.
+//
+//	try { 1 / 0; } catch (e) { println(e.message); } finally { println("done"); }
+func evalTryStatement(node *ast.TryStatement, env *object.Environment) object.Object {
+	result := Eval(node.Block, env)
+
+	if err, ok := result.(*object.Error); ok && node.Catch != nil {
+		// the parameter is only visible inside the catch block
+		catchEnv := object.NewBlockEnvironment(env)
+		catchEnv.Set(node.Param.Value, &object.Exception{Error: err})
+		result = Eval(node.Catch, catchEnv)
+	}
+
+	if node.Finally != nil {
+		finally := Eval(node.Finally, env)
+
+		switch finally.(type) {
+		case *object.ReturnValue, *object.Error, *object.Exit, *object.Break, *object.Continue:
+			return finally
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,111 @@
+package eval
+
+import (
+	"devscript/src/object"
+	"testing"
+)
+
+func TestTryCatch(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		// errors raised by the interpreter
+		{`var m = ""; try { 1 / 0; } catch (e) { m = e.message; } m;`, "division by zero"},
+		{`var m = ""; try { 1 / 0; } catch (e) { m = e.type; } m;`, "RuntimeError"},
+		// errors raised by builtins
+		{`var m = ""; try { len(1); } catch (e) { m = e.message; } m;`, "argument to `len` not supported, got INTEGER"},
+		// errors raised inside user functions
+		{`var f = func() { return -true; }; var m = ""; try { f(); } catch (e) { m = e.message; } m;`, "unknown operator: -BOOLEAN"},
+		// thrown values
+		{`var m = ""; try { throw "invalid input"; } catch (e) { m = e.type + ": " + e.message; } m;`, "Error: invalid input"},
+		{`var m = ""; try { throw 42; } catch (e) { m = e.message; } m;`, "42"},
+		{`var m = ""; try { throw {"code": "E1"}; } catch (e) { m = e.value.code; } m;`, "E1"},
+		// rethrown errors keep their message
+		{`var m = ""; try { try { throw "inner"; } catch (e) { throw e; } } catch (e) { m = e.message; } m;`, "inner"},
+		// the finally block always runs
+		{`var m = "a"; try { m = m + "b"; } finally { m = m + "c"; } m;`, "abc"},
+		{`var m = "a"; try { throw "x"; } catch (e) { m = m + "b"; } finally { m = m + "c"; } m;`, "abc"},
+	}
+
+	for _, tt := range tests {
+		testStringObject(t, testEval(tt.input), tt.expected)
+	}
+}
+
+func TestTryControlFlow(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected int64
+	}{
+		{`var f = func() { try { return 1; } catch (e) { return 2; } }; f();`, 1},
+		{`var f = func() { try { throw "x"; } catch (e) { return 2; } }; f();`, 2},
+		// a return in the finally block replaces the result
+		{`var f = func() { try { return 1; } finally { return 3; } }; f();`, 3},
+		{`var f = func() { try { throw "x"; } finally { return 3; } }; f();`, 3},
+		{`var i = 0; while (true) { try { i = i + 1; if (i == 5) { break; } } catch (e) {} } i;`, 5},
+	}
+
+	for _, tt := range tests {
+		testIntegerObject(t, testEval(tt.input), tt.expected)
+	}
+}
+
+func TestUncaughtErrors(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{`throw "boom";`, "ERROR: 1:1: boom"},
+		// errors in the catch block are not caught by the same try statement
+		{`try { throw "a"; } catch (e) { throw "b"; }`, "ERROR: 1:32: b"},
+		// finally does not catch the error
+		{`var x = 0; try { throw "a"; } finally { x = 1; }`, "ERROR: 1:18: a"},
+		{`try { 1; } catch (e) { 2; } foo.bar;`, "ERROR: 1:29: identifier not found: foo"},
+		{`5.message;`, "ERROR: 1:1: property access not supported: INTEGER"},
+		{`try { throw 1; } catch (e) { e.code; }`, "ERROR: 1:30: unknown property code of EXCEPTION"},
+	}
+
+	for _, tt := range tests {
+		evaluated := testEval(tt.input)
+		errObj, ok := evaluated.(*object.Error)
+		if !ok {
+			t.Errorf("no error object returned for %q. got=%T (%+v)", tt.input, evaluated, evaluated)
+			continue
+		}
+		if errObj.Inspect() != tt.expected {
+			t.Errorf("wrong error. expected=%q, got=%q", tt.expected, errObj.Inspect())
+		}
+	}
+}
+
+func TestExceptionStack(t *testing.T) {
+	input := `var inner = func() {
+  throw "failed";
+};
+var outer = func() {
+  inner();
+};
+var stack = [];
+try {
+  outer();
+} catch (e) {
+  stack = e.stack;
+}
+stack;`
+
+	evaluated := testEval(input)
+	array, ok := evaluated.(*object.Array)
+	if !ok {
+		t.Fatalf("object is not Array. got=%T (%+v)", evaluated, evaluated)
+	}
+
+	expected := []string{"<main> (9:3)", "function (5:3)", "function (2:3)"}
+	if len(array.Elements) != len(expected) {
+		t.Fatalf("wrong stack size. expected=%d, got=%d (%s)", len(expected), len(array.Elements), array.Inspect())
+	}
+
+	for i, frame := range expected {
+		testStringObject(t, array.Elements[i], frame)
+	}
+}
@@ -0,0 +1,33 @@
+package eval
+
+import (
+	"devscript/src/ast"
+	"devscript/src/object"
+	"devscript/src/token"
+)
+
+// Keywords of variable statements and the declarations they make
+var declarations = map[token.TokenType]object.Declaration{
+	token.VAR:   object.VAR_DECLARATION,
+	token.LET:   object.LET_DECLARATION,
+	token.CONST: object.CONST_DECLARATION,
+}
+
+// evaluates a variable statement,
+// var declares the variable in the enclosing function, let and const in the enclosing block
+//
+//	var x = 5;
+//	let y = x * 2;
+//	const z = 10;
+func evalVarStatement(node *ast.VarStatement, env *object.Environment) object.Object {
+	val := Eval(node.Value, env)
+	if isError(val) {
+		return val
+	}
+
+	if err := env.Declare(node.Name.Value, val, declarations[node.Token.Type]); err != nil {
+		return newError("%s", err)
+	}
+
+	return val
+}
@@ -0,0 +1,365 @@
+package formatter
+
+import (
+	"devscript/src/lexer"
+	"devscript/src/token"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// Indentation of one level of nesting
+const indentUnit = "    "
+
+// Format returns the source code of a program in the standard layout.
+//
+// The line breaks and comments of the source are kept, at most one blank line
+// is kept in a row, lines are indented by one level per open bracket and
+// tokens on the same line are separated by single spaces where the layout needs them.
+//
+//	var x=1+2;	// var x = 1 + 2;
+//	if(x>2){	// if (x > 2) {
+//	println( x );	//     println(x);
+//	}		// }
+//
+// The source is expected to have no syntax errors. An error is returned
+// if the formatted code would not read as the same tokens as the source.
+func Format(source string) (string, error) {
+	p := &printer{
+		source:     source,
+		lineStarts: lineStarts(source),
+		brackets:   []*bracket{{indent: -1, block: true}},
+	}
+	formatted := p.print()
+
+	if err := sameTokens(source, formatted); err != nil {
+		return "", err
+	}
+	return formatted, nil
+}
+
+// An open bracket, or the ${ of an interpolated string
+type bracket struct {
+	// indentation of the line the bracket is opened on
+	indent int
+	// true for the { of a block, false for the { of a hash
+	block bool
+	// number of ? inside the bracket still waiting for their :
+	conditionals int
+}
+
+// writes the tokens of the source in the standard layout
+type printer struct {
+	source string
+	// byte offset of the first character of every line
+	lineStarts []int
+
+	out strings.Builder
+	// indentation of the current line
+	indent int
+	// brackets opened and not closed yet, innermost last.
+	// The first bracket stands for the program itself and is never closed.
+	brackets []*bracket
+
+	// the last token written, with Type "" before the first token
+	prev token.Token
+	// true if the last token written ends an operand, so a following - is binary
+	//
+	//	x - 1	// after x
+	//	f(-1)	// not after (
+	operand bool
+	// true if the last token written is a prefix operator
+	unary bool
+}
+
+func (p *printer) print() string {
+	lex := lexer.New(p.source)
+
+	for {
+		tok := lex.NextToken()
+
+		newlines := p.printGap(tok)
+		if tok.Type == token.EOF {
+			break
+		}
+
+		if newlines > 0 {
+			p.lineBreak(newlines, closesBracket(tok.Type))
+		} else if p.prev.Type != "" && p.needsSpace(tok) {
+			p.out.WriteString(" ")
+		}
+
+		p.printToken(tok)
+	}
+
+	if p.out.Len() > 0 {
+		p.out.WriteString("\n")
+	}
+	return p.out.String()
+}
+
+// writes the comments between the last token and tok,
+// returns the number of line breaks left before tok
+func (p *printer) printGap(tok token.Token) int {
+	start := 0
+	if p.prev.Type != "" {
+		start = p.offset(p.prev.End)
+	}
+	gap := p.source[start:p.offset(tok.Pos)]
+
+	newlines := 0
+	for i, line := range strings.Split(gap, "\n") {
+		if i > 0 {
+			newlines++
+		}
+
+		comment := strings.TrimSpace(line)
+		if comment == "" {
+			continue
+		}
+
+		// a comment after a token on the same line
+		if i == 0 && p.prev.Type != "" {
+			p.out.WriteString(" " + comment)
+			continue
+		}
+
+		// a comment line is indented like the lines inside the bracket, even before a }
+		p.lineBreak(newlines, false)
+		p.out.WriteString(comment)
+		newlines = 0
+	}
+
+	return newlines
+}
+
+// starts a new line, keeping at most one blank line.
+// A line starting with a closing bracket goes back to the line its bracket is opened on.
+// Nothing is written before the first line of the program.
+func (p *printer) lineBreak(newlines int, closing bool) {
+	if newlines > 2 {
+		newlines = 2
+	}
+	if p.out.Len() > 0 {
+		p.out.WriteString(strings.Repeat("\n", newlines))
+	}
+
+	p.indent = p.top().indent + 1
+	if closing {
+		p.indent = p.top().indent
+	}
+
+	p.out.WriteString(strings.Repeat(indentUnit, p.indent))
+}
+
+// writes the token as written in the source and keeps track of the open brackets
+func (p *printer) printToken(tok token.Token) {
+	p.out.WriteString(p.source[p.offset(tok.Pos):p.offset(tok.End)])
+
+	switch {
+	case tok.Type == token.QUESTION:
+		p.top().conditionals++
+	case tok.Type == token.COLON && p.top().conditionals > 0:
+		p.top().conditionals--
+	}
+
+	if closesBracket(tok.Type) && len(p.brackets) > 1 {
+		// brackets opened after a bracket closed on a continuation line
+		// are indented like the line the closed bracket is opened on
+		//
+		//	if (f(1,
+		//	    2)) {
+		//	    x;
+		//	}
+		if closed := p.top(); closed.indent < p.indent {
+			p.indent = closed.indent
+		}
+		p.brackets = p.brackets[:len(p.brackets)-1]
+	}
+	if opensBracket(tok.Type) {
+		p.brackets = append(p.brackets, &bracket{indent: p.indent, block: p.opensBlock(tok)})
+	}
+
+	p.unary = p.isUnary(tok.Type)
+	switch tok.Type {
+	case token.IDENT, token.INT, token.FLOAT, token.STRING, token.STRING_TAIL,
+		token.TRUE, token.FALSE, token.NULL, token.RPAREN, token.RBRACKET:
+		p.operand = true
+	case token.INCREMENT, token.DECREMENT:
+		// x++ ends an operand, ++x starts one
+	default:
+		p.operand = false
+	}
+
+	p.prev = tok
+}
+
+// returns the innermost open bracket
+func (p *printer) top() *bracket {
+	return p.brackets[len(p.brackets)-1]
+}
+
+// returns true if tok is the { of a block, written after the last token
+//
+//	if (x) {	while (x) {	else {	try {	finally {
+func (p *printer) opensBlock(tok token.Token) bool {
+	if tok.Type != token.LBRACE {
+		return false
+	}
+
+	switch p.prev.Type {
+	case "", token.RPAREN, token.ELSE, token.TRY, token.FINALLY, token.SEMICOLON, token.RBRACE:
+		return true
+	}
+	return false
+}
+
+// returns true if tok is separated from the last token by a space,
+// both are on the same line
+func (p *printer) needsSpace(tok token.Token) bool {
+	prev := p.prev.Type
+
+	switch {
+	// - -x is not written as --x
+	case prev == token.MINUS && (tok.Type == token.MINUS || tok.Type == token.DECREMENT):
+		return true
+	case prev == token.LPAREN || prev == token.LBRACKET || prev == token.DOT ||
+		prev == token.STRING_HEAD || prev == token.STRING_MIDDLE:
+		return false
+	// unary operators stick to their operand: !x, -x, ++x
+	case p.unary:
+		return false
+	case tok.Type == token.RPAREN || tok.Type == token.RBRACKET || tok.Type == token.COMMA ||
+		tok.Type == token.SEMICOLON || tok.Type == token.DOT ||
+		tok.Type == token.STRING_MIDDLE || tok.Type == token.STRING_TAIL:
+		return false
+	// x++
+	case (tok.Type == token.INCREMENT || tok.Type == token.DECREMENT) && p.operand:
+		return false
+	// calls and index expressions: f(x), func(x), a[0]
+	case tok.Type == token.LPAREN && (p.operand || prev == token.FUNCTION):
+		return false
+	case tok.Type == token.LBRACKET && p.operand:
+		return false
+	case isBinary(prev, !p.unary) || isBinary(tok.Type, p.operand):
+		return true
+	case prev == token.COMMA || prev == token.SEMICOLON || prev == token.COLON:
+		return true
+	case isKeyword(prev) || isKeyword(tok.Type):
+		return true
+	// x ? y : z, but {"a": 1}
+	case tok.Type == token.COLON:
+		return p.top().conditionals > 0
+	// if (x) {
+	case tok.Type == token.LBRACE && prev == token.RPAREN:
+		return true
+	// {}
+	case prev == token.LBRACE && tok.Type == token.RBRACE:
+		return false
+	// { x; } but {"a": 1}
+	case prev == token.LBRACE:
+		return p.top().block
+	case tok.Type == token.RBRACE:
+		return p.top().block
+	}
+
+	// func add(, or anything else the layout has no rule for, is left as written
+	return p.offset(p.prev.End) < p.offset(tok.Pos)
+}
+
+// returns true if the token is a prefix operator when written after the last token
+func (p *printer) isUnary(tokenType token.TokenType) bool {
+	switch tokenType {
+	case token.BANG, token.BIT_NOT:
+		return true
+	case token.MINUS, token.INCREMENT, token.DECREMENT:
+		return !p.operand
+	}
+	return false
+}
+
+// returns true for an infix operator, a - is only infix after an operand
+func isBinary(tokenType token.TokenType, afterOperand bool) bool {
+	switch tokenType {
+	case token.MINUS:
+		return afterOperand
+	case token.ASSIGN, token.PLUS_ASSIGN, token.MINUS_ASSIGN, token.ASTERISK_ASSIGN,
+		token.SLASH_ASSIGN, token.PERCENT_ASSIGN,
+		token.PLUS, token.ASTERISK, token.SLASH, token.PERCENT, token.POWER,
+		token.LT, token.GT, token.LT_EQ, token.GT_EQ, token.EQ, token.NOT_EQ,
+		token.AND, token.OR, token.BIT_AND, token.BIT_OR, token.BIT_XOR,
+		token.SHIFT_LEFT, token.SHIFT_RIGHT, token.QUESTION, token.NULL_COALESCE:
+		return true
+	}
+	return false
+}
+
+// returns true for the keywords that are followed or preceded by a space,
+// func is left out as anonymous functions are written func(x)
+func isKeyword(tokenType token.TokenType) bool {
+	switch tokenType {
+	case token.VAR, token.LET, token.CONST, token.IF, token.ELSE, token.RETURN,
+		token.WHILE, token.FOR, token.IN, token.THROW, token.TRY, token.CATCH, token.FINALLY:
+		return true
+	}
+	return false
+}
+
+// ( [ { and the ${ of an interpolated string
+func opensBracket(tokenType token.TokenType) bool {
+	switch tokenType {
+	case token.LPAREN, token.LBRACKET, token.LBRACE, token.STRING_HEAD, token.STRING_MIDDLE:
+		return true
+	}
+	return false
+}
+
+// ) ] } and the } ending an expression of an interpolated string
+func closesBracket(tokenType token.TokenType) bool {
+	switch tokenType {
+	case token.RPAREN, token.RBRACKET, token.RBRACE, token.STRING_MIDDLE, token.STRING_TAIL:
+		return true
+	}
+	return false
+}
+
+// returns the byte offset of a position of a token in the source
+func (p *printer) offset(pos token.Position) int {
+	if pos.Line < 1 || pos.Line > len(p.lineStarts) {
+		return len(p.source)
+	}
+
+	offset := p.lineStarts[pos.Line-1]
+	// columns count characters, not bytes
+	for column := 1; column < pos.Column && offset < len(p.source); column++ {
+		_, width := utf8.DecodeRuneInString(p.source[offset:])
+		offset += width
+	}
+	return offset
+}
+
+// returns the byte offset of the first character of every line
+func lineStarts(source string) []int {
+	starts := []int{0}
+	for i, ch := range source {
+		if ch == '\n' {
+			starts = append(starts, i+1)
+		}
+	}
+	return starts
+}
+
+// returns an error if the formatted code is not made of the same tokens as the source
+func sameTokens(source, formatted string) error {
+	sourceLexer, formattedLexer := lexer.New(source), lexer.New(formatted)
+
+	for {
+		expected, got := sourceLexer.NextToken(), formattedLexer.NextToken()
+		if expected.Type != got.Type || expected.Literal != got.Literal {
+			return fmt.Errorf("formatting changes %q at %s into %q", expected.Literal, expected.Pos, got.Literal)
+		}
+		if expected.Type == token.EOF {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package formatter
+
+import "testing"
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"var x=1+2;", "var x = 1 + 2;\n"},
+		{"x  =  y  ??  z ;", "x = y ?? z;\n"},
+		{"println( x ,y );", "println(x, y);\n"},
+		{"var a=-x*-(y -z);", "var a = -x * -(y - z);\n"},
+		{"var a = - -x;", "var a = - -x;\n"},
+		{"var a=!b&&~c;", "var a = !b && ~c;\n"},
+		{"i ++; -- j;", "i++; --j;\n"},
+		{"a [0] . b;", "a[0].b;\n"},
+		{"var f=func (x){return x;};", "var f = func(x) { return x; };\n"},
+		{"func add(a,b){return a+b;}", "func add(a, b) { return a + b; }\n"},
+		{"if(x){y;}else{z;}", "if (x) { y; } else { z; }\n"},
+		{"for(let i=0;i<3;i++){}", "for (let i = 0; i < 3; i++) {}\n"},
+		{"for(;;){break;}", "for (;;) { break; }\n"},
+		{"for(k,v in h){}", "for (k, v in h) {}\n"},
+		{"try{a();}catch(e){}finally{b();}", "try { a(); } catch (e) {} finally { b(); }\n"},
+		{"var h={ \"a\" : 1,\"b\":[1,2] };", "var h = {\"a\": 1, \"b\": [1, 2]};\n"},
+		{"var c=a?b:c;", "var c = a ? b : c;\n"},
+		{"var c={\"k\": a?b:c};", "var c = {\"k\": a ? b : c};\n"},
+		{"var s=\"a ${ x + 1 } b\";", "var s = \"a ${x + 1} b\";\n"},
+		// literals are kept as written
+		{"var s = \"\\t\\u{1F600}\"; var n = 1e3;", "var s = \"\\t\\u{1F600}\"; var n = 1e3;\n"},
+		// identifiers can contain -
+		{"my-var-1;", "my-var-1;\n"},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		formatted, err := Format(tt.input)
+		if err != nil {
+			t.Errorf("Format(%q) returned an error: %v", tt.input, err)
+			continue
+		}
+		if formatted != tt.expected {
+			t.Errorf("Format(%q) wrong. expected=%q, got=%q", tt.input, tt.expected, formatted)
+		}
+	}
+}
+
+func TestFormatLayout(t *testing.T) {
+	input := `
+
+// adds two numbers
+func add(a,b){
+        return a+b;   // the sum
+}
+
+
+
+var arr = [
+1,
+  [2,
+ 3],
+];
+if (add(1,
+2) > 2) {
+  println("big");
+    // nothing else
+}
+var raw = ` + "`line 1\n    line 2`" + `;
+// end`
+
+	expected := `// adds two numbers
+func add(a, b) {
+    return a + b; // the sum
+}
+
+var arr = [
+    1,
+    [2,
+        3],
+];
+if (add(1,
+    2) > 2) {
+    println("big");
+    // nothing else
+}
+var raw = ` + "`line 1\n    line 2`" + `;
+// end
+`
+
+	formatted, err := Format(input)
+	if err != nil {
+		t.Fatalf("Format returned an error: %v", err)
+	}
+	if formatted != expected {
+		t.Errorf("wrong layout. expected=\n%s\ngot=\n%s", expected, formatted)
+	}
+
+	// formatting twice gives the same code
+	again, err := Format(formatted)
+	if err != nil {
+		t.Fatalf("Format returned an error: %v", err)
+	}
+	if again != formatted {
+		t.Errorf("formatting is not stable. expected=\n%s\ngot=\n%s", formatted, again)
+	}
+}
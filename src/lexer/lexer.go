@@ -1,17 +1,34 @@
 package lexer
 
-import "devscript/src/token"
+import (
+	"devscript/src/token"
+	"unicode"
+	"unicode/utf8"
+)
 
 type Lexer struct {
 	input        string
-	position     int  // current position in input (points to current char)
-	readPosition int  // current reading position in input (after current char)
-	char         byte // current char under examination
+	position     int  // current byte offset in input (points to current char)
+	readPosition int  // current reading byte offset in input (after current char)
+	char         rune // current char under examination, a Unicode code point
+
+	file   string // name of the file the input is read from, may be empty
+	line   int    // line of the current char, starts at 1
+	column int    // column of the current char, starts at 1
+
+	// ${...} of interpolated strings the lexer is inside of, innermost last
+	interpolations []interpolation
 }
 
 // return Lexer instance
 func New(input string) *Lexer {
-	lexer := &Lexer{input: input}
+	return NewWithFile(input, "")
+}
+
+// return Lexer instance for the contents of a file,
+// the file name is stored in the position of every token
+func NewWithFile(input string, file string) *Lexer {
+	lexer := &Lexer{input: input, file: file, line: 1}
 	lexer.readChar()
 	return lexer
 }
@@ -19,17 +36,30 @@ func New(input string) *Lexer {
 /*
 reads next character in the input string
 and increments the [position] & [readPosition]
+
+The input is decoded as UTF-8, so a char is a code point and the column
+counts code points, invalid bytes are read one by one as utf8.RuneError.
 */
 func (lexer *Lexer) readChar() {
+	// update the line and column of the new current char
+	if lexer.char == '\n' {
+		lexer.line++
+		lexer.column = 0
+	}
+	if lexer.readPosition <= len(lexer.input) {
+		lexer.column++
+	}
+
 	// check for EOF
+	width := 1
 	if lexer.readPosition >= len(lexer.input) {
 		lexer.char = 0
 	} else {
-		lexer.char = lexer.input[lexer.readPosition]
+		lexer.char, width = utf8.DecodeRuneInString(lexer.input[lexer.readPosition:])
 	}
 
 	lexer.position = lexer.readPosition
-	lexer.readPosition += 1
+	lexer.readPosition += width
 }
 
 /*
@@ -40,18 +70,30 @@ func (lexer *Lexer) NextToken() token.Token {
 
 	lexer.skipWhitespace()
 
+	// position of the first char of the token
+	pos := lexer.currentPosition()
+
 	switch lexer.char {
 	case '=':
 		// check for "==" (equality operator)
 		if lexer.peekChar() == '=' {
-			ch := lexer.char
-			lexer.readChar()
-			tok = token.Token{Type: token.EQ, Literal: string(ch) + string(lexer.char)}
+			tok = lexer.newTwoCharToken(token.EQ)
 		} else {
 			tok = newToken(token.ASSIGN, lexer.char)
 		}
 	case ';':
 		tok = newToken(token.SEMICOLON, lexer.char)
+	case ':':
+		tok = newToken(token.COLON, lexer.char)
+	case '?':
+		// check for "??" (null coalescing)
+		if lexer.peekChar() == '?' {
+			tok = lexer.newTwoCharToken(token.NULL_COALESCE)
+		} else {
+			tok = newToken(token.QUESTION, lexer.char)
+		}
+	case '.':
+		tok = newToken(token.DOT, lexer.char)
 	case '(':
 		tok = newToken(token.LPAREN, lexer.char)
 	case ')':
@@ -59,11 +101,46 @@ func (lexer *Lexer) NextToken() token.Token {
 	case ',':
 		tok = newToken(token.COMMA, lexer.char)
 	case '+':
-		tok = newToken(token.PLUS, lexer.char)
+		// check for "++" (increment) and "+=" (compound assignment)
+		switch lexer.peekChar() {
+		case '+':
+			tok = lexer.newTwoCharToken(token.INCREMENT)
+		case '=':
+			tok = lexer.newTwoCharToken(token.PLUS_ASSIGN)
+		default:
+			tok = newToken(token.PLUS, lexer.char)
+		}
 	case '-':
-		tok = newToken(token.MINUS, lexer.char)
+		// check for "--" (decrement) and "-=" (compound assignment)
+		switch lexer.peekChar() {
+		case '-':
+			tok = lexer.newTwoCharToken(token.DECREMENT)
+		case '=':
+			tok = lexer.newTwoCharToken(token.MINUS_ASSIGN)
+		default:
+			tok = newToken(token.MINUS, lexer.char)
+		}
 	case '*':
-		tok = newToken(token.ASTERISK, lexer.char)
+		// check for "**" (power operator) and "*=" (compound assignment)
+		switch lexer.peekChar() {
+		case '*':
+			tok = lexer.newTwoCharToken(token.POWER)
+		case '=':
+			tok = lexer.newTwoCharToken(token.ASTERISK_ASSIGN)
+		default:
+			tok = newToken(token.ASTERISK, lexer.char)
+		}
+	case '%':
+		// check for "%=" (compound assignment)
+		if lexer.peekChar() == '=' {
+			tok = lexer.newTwoCharToken(token.PERCENT_ASSIGN)
+		} else {
+			tok = newToken(token.PERCENT, lexer.char)
+		}
+	case '^':
+		tok = newToken(token.BIT_XOR, lexer.char)
+	case '~':
+		tok = newToken(token.BIT_NOT, lexer.char)
 	case '/':
 		// check for "//" (comment)
 		if lexer.peekChar() == '/' {
@@ -73,32 +150,77 @@ func (lexer *Lexer) NextToken() token.Token {
 			// skip until end of line
 			lexer.skipLine()
 
-			tok = lexer.NextToken()
+			return lexer.NextToken()
+		} else if lexer.peekChar() == '=' {
+			// check for "/=" (compound assignment)
+			tok = lexer.newTwoCharToken(token.SLASH_ASSIGN)
 		} else {
 			tok = newToken(token.SLASH, lexer.char)
 		}
 	case '!':
 		// check for "!=" (NOT_EQ)
 		if lexer.peekChar() == '=' {
-			ch := lexer.char
-			lexer.readChar()
-			tok = token.Token{Type: token.NOT_EQ, Literal: string(ch) + string(lexer.char)}
+			tok = lexer.newTwoCharToken(token.NOT_EQ)
 		} else {
 			tok = newToken(token.BANG, lexer.char)
 		}
+	case '&':
+		// check for "&&" (logical and)
+		if lexer.peekChar() == '&' {
+			tok = lexer.newTwoCharToken(token.AND)
+		} else {
+			tok = newToken(token.BIT_AND, lexer.char)
+		}
+	case '|':
+		// check for "||" (logical or)
+		if lexer.peekChar() == '|' {
+			tok = lexer.newTwoCharToken(token.OR)
+		} else {
+			tok = newToken(token.BIT_OR, lexer.char)
+		}
 	case '<':
-		tok = newToken(token.LT, lexer.char)
+		// check for "<=" (less than or equal) and "<<" (shift left)
+		switch lexer.peekChar() {
+		case '=':
+			tok = lexer.newTwoCharToken(token.LT_EQ)
+		case '<':
+			tok = lexer.newTwoCharToken(token.SHIFT_LEFT)
+		default:
+			tok = newToken(token.LT, lexer.char)
+		}
 	case '>':
-		tok = newToken(token.GT, lexer.char)
+		// check for ">=" (greater than or equal) and ">>" (shift right)
+		switch lexer.peekChar() {
+		case '=':
+			tok = lexer.newTwoCharToken(token.GT_EQ)
+		case '>':
+			tok = lexer.newTwoCharToken(token.SHIFT_RIGHT)
+		default:
+			tok = newToken(token.GT, lexer.char)
+		}
 	case '{':
 		tok = newToken(token.LBRACE, lexer.char)
+		if len(lexer.interpolations) > 0 {
+			lexer.interpolations[len(lexer.interpolations)-1].braces++
+		}
 	case '}':
-		tok = newToken(token.RBRACE, lexer.char)
-	case '"':
-		{
-			tok.Type = token.STRING
-			tok.Literal = lexer.readString()
+		if quote, ok := lexer.closeInterpolation(); ok {
+			tok = lexer.readStringPart(quote, token.STRING_TAIL, token.STRING_MIDDLE)
+			if tok.Type == token.ERROR {
+				// the error points at the opening quote of the string
+				pos = quote
+			}
+		} else {
+			tok = newToken(token.RBRACE, lexer.char)
 		}
+	case '[':
+		tok = newToken(token.LBRACKET, lexer.char)
+	case ']':
+		tok = newToken(token.RBRACKET, lexer.char)
+	case '"':
+		tok = lexer.readString()
+	case '`':
+		tok = lexer.readRawString()
 	case 0:
 		tok.Literal = ""
 		tok.Type = token.EOF
@@ -107,10 +229,11 @@ func (lexer *Lexer) NextToken() token.Token {
 			tok.Literal = lexer.readIdentifier()
 			// check if the identifier is a token
 			tok.Type = token.LookupIdent(tok.Literal)
+			tok.Pos, tok.End = pos, lexer.currentPosition()
 			return tok
 		} else if isDigit(lexer.char) {
-			tok.Type = token.INT
-			tok.Literal = lexer.readNumber()
+			tok.Type, tok.Literal = lexer.readNumber()
+			tok.Pos, tok.End = pos, lexer.currentPosition()
 			return tok
 		} else {
 			tok = newToken(token.ILLEGAL, lexer.char)
@@ -118,23 +241,51 @@ func (lexer *Lexer) NextToken() token.Token {
 	}
 
 	lexer.readChar()
+	tok.Pos, tok.End = pos, lexer.currentPosition()
 	return tok
 }
 
+// returns the position of the current char
+func (lexer *Lexer) currentPosition() token.Position {
+	return token.Position{File: lexer.file, Line: lexer.line, Column: lexer.column}
+}
+
 // newToken return new instance of type Token struct
 //
 //	{Type: TokenType, Literal: string}
-func newToken(tokenType token.TokenType, ch byte) token.Token {
+func newToken(tokenType token.TokenType, ch rune) token.Token {
 	return token.Token{Type: tokenType, Literal: string(ch)}
 }
 
+// newTwoCharToken returns a token made of the current and the next char,
+// the lexer is advanced to the next char
+//
+//	==, !=, <=, >=, &&, ||, **, <<, >>
+func (lexer *Lexer) newTwoCharToken(tokenType token.TokenType) token.Token {
+	ch := lexer.char
+	lexer.readChar()
+	return token.Token{Type: tokenType, Literal: string(ch) + string(lexer.char)}
+}
+
 // function to return an identifier name
 // using maximal munch rule (longest common prefix)
+//
+// A '-' is part of the identifier only when a letter, digit or _ follows it,
+// so the operators after an identifier are read as operators
+//
+//	my-var		// IDENT
+//	i--, i-=1	// IDENT, DECREMENT and IDENT, MINUS_ASSIGN, INT
 func (lexer *Lexer) readIdentifier() string {
 	start := lexer.position
 
 	// readChar until char is not a letter
 	for validIdentifier(lexer.char) {
+		if lexer.char == '-' {
+			next := lexer.peekChar()
+			if next == '-' || !validIdentifier(next) {
+				break
+			}
+		}
 		lexer.readChar()
 	}
 
@@ -142,64 +293,94 @@ func (lexer *Lexer) readIdentifier() string {
 	return lexer.input[start:lexer.position]
 }
 
-// function to return number
+// function to return number and its token type
 // using maximal munch rule (longest common prefix)
-func (lexer *Lexer) readNumber() string {
+//
+//	5		// token.INT
+//	3.14		// token.FLOAT
+//	1e-9		// token.FLOAT
+func (lexer *Lexer) readNumber() (token.TokenType, string) {
 	start := lexer.position
+	tokenType := token.TokenType(token.INT)
 
 	// readChar until char is not a number
-	for isDigit(lexer.char) {
+	lexer.readDigits()
+
+	// fraction part, the '.' must be followed by a digit
+	if lexer.char == '.' && isDigit(lexer.peekChar()) {
+		tokenType = token.FLOAT
 		lexer.readChar()
+		lexer.readDigits()
+	}
+
+	// exponent part, 'e' or 'E' followed by an optional sign and digits
+	if lexer.char == 'e' || lexer.char == 'E' {
+		offset := 1
+		if lexer.peekCharAt(offset) == '+' || lexer.peekCharAt(offset) == '-' {
+			offset++
+		}
+
+		if isDigit(lexer.peekCharAt(offset)) {
+			tokenType = token.FLOAT
+			for i := 0; i < offset; i++ {
+				lexer.readChar()
+			}
+			lexer.readDigits()
+		}
 	}
 
 	// end position = lexer.position
-	return lexer.input[start:lexer.position]
+	return tokenType, lexer.input[start:lexer.position]
 }
 
-func (lexer *Lexer) readString() string {
-	start := lexer.position + 1
-
-	for {
+// readChar until char is not a digit
+func (lexer *Lexer) readDigits() {
+	for isDigit(lexer.char) {
 		lexer.readChar()
-
-		// readChar until char is not a " or, end of file
-		if lexer.char == '"' || lexer.char == 0 {
-			break
-		}
 	}
+}
 
-	// end position = lexer.position
-	return lexer.input[start:lexer.position]
+func (lexer *Lexer) peekChar() rune {
+	return lexer.peekCharAt(1)
 }
 
-func (lexer *Lexer) peekChar() byte {
-	if lexer.readPosition >= len(lexer.input) {
+// returns the character offset characters after the current char
+// without advancing the lexer, peekCharAt(1) is the same as peekChar()
+func (lexer *Lexer) peekCharAt(offset int) rune {
+	position := lexer.position
+	for ; offset > 0 && position < len(lexer.input); offset-- {
+		_, width := utf8.DecodeRuneInString(lexer.input[position:])
+		position += width
+	}
+
+	if position >= len(lexer.input) {
 		return 0
-	} else {
-		return lexer.input[lexer.readPosition]
 	}
+	ch, _ := utf8.DecodeRuneInString(lexer.input[position:])
+	return ch
 }
 
 // returns true only if the identifier starts with a letter or _ else false
-func validStartIdentifier(ch byte) bool {
+func validStartIdentifier(ch rune) bool {
 	return isLetter(ch) || ch == '_'
 }
 
-func validIdentifier(ch byte) bool {
-	return isLetter(ch) || isDigit(ch) || ch == '_' || ch == '-'
+func validIdentifier(ch rune) bool {
+	return isLetter(ch) || unicode.IsDigit(ch) || ch == '_' || ch == '-'
 }
 
-func isLetter(ch byte) bool {
-	return ('a' <= ch && ch <= 'z') || ('A' <= ch && ch <= 'Z')
+// returns true for Unicode letters, so identifiers like größe are valid
+func isLetter(ch rune) bool {
+	return unicode.IsLetter(ch)
 }
 
-func isDigit(ch byte) bool {
+func isDigit(ch rune) bool {
 	return ch >= '0' && ch <= '9'
 }
 
 // skips the current line
 func (lexer *Lexer) skipLine() {
-	for lexer.char != '\n' {
+	for lexer.char != '\n' && lexer.char != 0 {
 		lexer.readChar()
 	}
 }
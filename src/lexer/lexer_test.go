@@ -29,6 +29,16 @@ func TestNextToken(t *testing.T) {
 	10 != 9;
 	"Hello"
 	"Hello World"
+	[1, 2];
+	{"foo": "bar"}
+	3.14 1e-9 2.5E+3 7.e
+	while for break continue in
+	a && b || c
+	<= >= % ** & | ^ ~ << >> < >
+	+= -= *= /= %= ++ -- i-- i-=1 my-var x- y
+	a ? b : c ?? null
+	try catch finally throw e.message let const
+	var größe = "héllo 😀"; π_2 日本語 ŝ§
 	`
 
 	tests := []struct {
@@ -115,6 +125,86 @@ func TestNextToken(t *testing.T) {
 		{token.SEMICOLON, ";"},
 		{token.STRING, "Hello"},
 		{token.STRING, "Hello World"},
+		{token.LBRACKET, "["},
+		{token.INT, "1"},
+		{token.COMMA, ","},
+		{token.INT, "2"},
+		{token.RBRACKET, "]"},
+		{token.SEMICOLON, ";"},
+		{token.LBRACE, "{"},
+		{token.STRING, "foo"},
+		{token.COLON, ":"},
+		{token.STRING, "bar"},
+		{token.RBRACE, "}"},
+		{token.FLOAT, "3.14"},
+		{token.FLOAT, "1e-9"},
+		{token.FLOAT, "2.5E+3"},
+		{token.INT, "7"},
+		{token.DOT, "."},
+		{token.IDENT, "e"},
+		{token.WHILE, "while"},
+		{token.FOR, "for"},
+		{token.BREAK, "break"},
+		{token.CONTINUE, "continue"},
+		{token.IN, "in"},
+		{token.IDENT, "a"},
+		{token.AND, "&&"},
+		{token.IDENT, "b"},
+		{token.OR, "||"},
+		{token.IDENT, "c"},
+		{token.LT_EQ, "<="},
+		{token.GT_EQ, ">="},
+		{token.PERCENT, "%"},
+		{token.POWER, "**"},
+		{token.BIT_AND, "&"},
+		{token.BIT_OR, "|"},
+		{token.BIT_XOR, "^"},
+		{token.BIT_NOT, "~"},
+		{token.SHIFT_LEFT, "<<"},
+		{token.SHIFT_RIGHT, ">>"},
+		{token.LT, "<"},
+		{token.GT, ">"},
+		{token.PLUS_ASSIGN, "+="},
+		{token.MINUS_ASSIGN, "-="},
+		{token.ASTERISK_ASSIGN, "*="},
+		{token.SLASH_ASSIGN, "/="},
+		{token.PERCENT_ASSIGN, "%="},
+		{token.INCREMENT, "++"},
+		{token.DECREMENT, "--"},
+		{token.IDENT, "i"},
+		{token.DECREMENT, "--"},
+		{token.IDENT, "i"},
+		{token.MINUS_ASSIGN, "-="},
+		{token.INT, "1"},
+		{token.IDENT, "my-var"},
+		{token.IDENT, "x"},
+		{token.MINUS, "-"},
+		{token.IDENT, "y"},
+		{token.IDENT, "a"},
+		{token.QUESTION, "?"},
+		{token.IDENT, "b"},
+		{token.COLON, ":"},
+		{token.IDENT, "c"},
+		{token.NULL_COALESCE, "??"},
+		{token.NULL, "null"},
+		{token.TRY, "try"},
+		{token.CATCH, "catch"},
+		{token.FINALLY, "finally"},
+		{token.THROW, "throw"},
+		{token.IDENT, "e"},
+		{token.DOT, "."},
+		{token.IDENT, "message"},
+		{token.LET, "let"},
+		{token.CONST, "const"},
+		{token.VAR, "var"},
+		{token.IDENT, "größe"},
+		{token.ASSIGN, "="},
+		{token.STRING, "héllo 😀"},
+		{token.SEMICOLON, ";"},
+		{token.IDENT, "π_2"},
+		{token.IDENT, "日本語"},
+		{token.IDENT, "ŝ"},
+		{token.ILLEGAL, "§"},
 		{token.EOF, ""},
 	}
 
@@ -132,3 +222,235 @@ func TestNextToken(t *testing.T) {
 		}
 	}
 }
+
+func TestTokenPositions(t *testing.T) {
+	input := "var x = 5;\n// comment\n\tx == 10;\n\"str\""
+
+	tests := []struct {
+		expectedType   token.TokenType
+		expectedLine   int
+		expectedColumn int
+	}{
+		{token.VAR, 1, 1},
+		{token.IDENT, 1, 5},
+		{token.ASSIGN, 1, 7},
+		{token.INT, 1, 9},
+		{token.SEMICOLON, 1, 10},
+		{token.IDENT, 3, 2},
+		{token.EQ, 3, 4},
+		{token.INT, 3, 7},
+		{token.SEMICOLON, 3, 9},
+		{token.STRING, 4, 1},
+		{token.EOF, 4, 6},
+	}
+
+	l := NewWithFile(input, "main.ds")
+
+	for i, tt := range tests {
+		tok := l.NextToken()
+
+		if tok.Type != tt.expectedType {
+			t.Fatalf("tests[%d] - tokentype wrong. expected=%q, got=%q", i, tt.expectedType, tok.Type)
+		}
+
+		if tok.Pos.Line != tt.expectedLine || tok.Pos.Column != tt.expectedColumn {
+			t.Fatalf("tests[%d] - position wrong. expected=%d:%d, got=%d:%d",
+				i, tt.expectedLine, tt.expectedColumn, tok.Pos.Line, tok.Pos.Column)
+		}
+
+		if tok.Pos.File != "main.ds" {
+			t.Fatalf("tests[%d] - file wrong. expected=%q, got=%q", i, "main.ds", tok.Pos.File)
+		}
+	}
+}
+
+func TestUnicodeTokenPositions(t *testing.T) {
+	// columns count code points, not bytes
+	input := "var größe = \"😀é\" + ü;\n日本 ≠"
+
+	tests := []struct {
+		expectedType   token.TokenType
+		expectedLine   int
+		expectedColumn int
+	}{
+		{token.VAR, 1, 1},
+		{token.IDENT, 1, 5},
+		{token.ASSIGN, 1, 11},
+		{token.STRING, 1, 13},
+		{token.PLUS, 1, 18},
+		{token.IDENT, 1, 20},
+		{token.SEMICOLON, 1, 21},
+		{token.IDENT, 2, 1},
+		{token.ILLEGAL, 2, 4},
+		{token.EOF, 2, 5},
+	}
+
+	l := New(input)
+
+	for i, tt := range tests {
+		tok := l.NextToken()
+
+		if tok.Type != tt.expectedType {
+			t.Fatalf("tests[%d] - tokentype wrong. expected=%q, got=%q", i, tt.expectedType, tok.Type)
+		}
+
+		if tok.Pos.Line != tt.expectedLine || tok.Pos.Column != tt.expectedColumn {
+			t.Fatalf("tests[%d] - position wrong. expected=%d:%d, got=%d:%d",
+				i, tt.expectedLine, tt.expectedColumn, tok.Pos.Line, tok.Pos.Column)
+		}
+	}
+}
+
+func TestInvalidUTF8(t *testing.T) {
+	l := New("x \xff y")
+
+	expected := []token.TokenType{token.IDENT, token.ILLEGAL, token.IDENT, token.EOF}
+	for i, tokenType := range expected {
+		tok := l.NextToken()
+		if tok.Type != tokenType {
+			t.Fatalf("tests[%d] - tokentype wrong. expected=%q, got=%q", i, tokenType, tok.Type)
+		}
+	}
+}
+
+func TestCommentAtEndOfInput(t *testing.T) {
+	l := New("x; // no newline after this comment")
+
+	expected := []token.TokenType{token.IDENT, token.SEMICOLON, token.EOF}
+	for i, tokenType := range expected {
+		tok := l.NextToken()
+		if tok.Type != tokenType {
+			t.Fatalf("tests[%d] - tokentype wrong. expected=%q, got=%q", i, tokenType, tok.Type)
+		}
+	}
+}
+
+func TestCommentBeforeToken(t *testing.T) {
+	l := New("// comment\nx;")
+
+	expected := []token.TokenType{token.IDENT, token.SEMICOLON, token.EOF}
+	for i, tokenType := range expected {
+		tok := l.NextToken()
+		if tok.Type != tokenType {
+			t.Fatalf("tests[%d] - tokentype wrong. expected=%q, got=%q", i, tokenType, tok.Type)
+		}
+	}
+}
+
+func TestStringLiterals(t *testing.T) {
+	tests := []struct {
+		input           string
+		expectedType    token.TokenType
+		expectedLiteral string
+	}{
+		{`"a\nb"`, token.STRING, "a\nb"},
+		{`"\t\r\\"`, token.STRING, "\t\r\\"},
+		{`"say \"hi\""`, token.STRING, `say "hi"`},
+		{`"\u{1F600} \u{e9}"`, token.STRING, "😀 é"},
+		{"`C:\\path\\n`", token.STRING, `C:\path\n`},
+		{"`first\r\nsecond`", token.STRING, "first\nsecond"},
+		{`"abc`, token.ERROR, "unterminated string literal"},
+		{"\"abc\n\"", token.STRING, "abc\n"},
+		// Windows line endings are read as \n, in both kinds of strings
+		{"\"a\r\nb\"", token.STRING, "a\nb"},
+		{"\"a\rb\"", token.STRING, "a\rb"},
+		{"`a\rb`", token.STRING, "a\rb"},
+		{"\"abc\\\r\n\"", token.ERROR, `invalid escape sequence \ at the end of a line`},
+		{"\"abc\nx;", token.ERROR, "unterminated string literal"},
+		{"\"abc\\\n\"", token.ERROR, `invalid escape sequence \ at the end of a line`},
+		{`"abc\`, token.ERROR, "unterminated string literal"},
+		{"`abc", token.ERROR, "unterminated raw string literal"},
+		{`"\q"`, token.ERROR, `invalid escape sequence \q`},
+		{`"\u41"`, token.ERROR, `invalid escape sequence \u, expected \u{...}`},
+		{`"\u{41"`, token.ERROR, `invalid escape sequence \u{41, expected }`},
+		{`"\u{}"`, token.ERROR, `invalid Unicode code point \u{}`},
+		{`"\u{D800}"`, token.ERROR, `invalid Unicode code point \u{D800}`},
+		{`"\u{1000000}"`, token.ERROR, `invalid Unicode code point \u{1000000}`},
+		{`"\$5 \${x}"`, token.STRING, "$5 ${x}"},
+	}
+
+	for i, tt := range tests {
+		tok := New(tt.input).NextToken()
+
+		if tok.Type != tt.expectedType {
+			t.Fatalf("tests[%d] - tokentype wrong. expected=%q, got=%q", i, tt.expectedType, tok.Type)
+		}
+
+		if tok.Literal != tt.expectedLiteral {
+			t.Fatalf("tests[%d] - literal wrong. expected=%q, got=%q", i, tt.expectedLiteral, tok.Literal)
+		}
+	}
+}
+
+func TestStringPositions(t *testing.T) {
+	l := NewWithFile("var s = \"a\\qb\";\n`one\ntwo` x\n\"three\nfour\" y", "main.ds")
+
+	tests := []struct {
+		expectedType   token.TokenType
+		expectedLine   int
+		expectedColumn int
+	}{
+		{token.VAR, 1, 1},
+		{token.IDENT, 1, 5},
+		{token.ASSIGN, 1, 7},
+		// the error points at the opening quote
+		{token.ERROR, 1, 9},
+		{token.SEMICOLON, 1, 15},
+		{token.STRING, 2, 1},
+		{token.IDENT, 3, 6},
+		{token.STRING, 4, 1},
+		{token.IDENT, 5, 7},
+		{token.EOF, 5, 8},
+	}
+
+	for i, tt := range tests {
+		tok := l.NextToken()
+
+		if tok.Type != tt.expectedType {
+			t.Fatalf("tests[%d] - tokentype wrong. expected=%q, got=%q", i, tt.expectedType, tok.Type)
+		}
+
+		if tok.Pos.Line != tt.expectedLine || tok.Pos.Column != tt.expectedColumn {
+			t.Fatalf("tests[%d] - position wrong. expected=%d:%d, got=%d:%d",
+				i, tt.expectedLine, tt.expectedColumn, tok.Pos.Line, tok.Pos.Column)
+		}
+	}
+}
+
+func TestInterpolatedString(t *testing.T) {
+	input := `"a ${x} b ${ {"k": "${y}"} } c" "${}"`
+
+	tests := []struct {
+		expectedType    token.TokenType
+		expectedLiteral string
+	}{
+		{token.STRING_HEAD, "a "},
+		{token.IDENT, "x"},
+		{token.STRING_MIDDLE, " b "},
+		{token.LBRACE, "{"},
+		{token.STRING, "k"},
+		{token.COLON, ":"},
+		{token.STRING_HEAD, ""},
+		{token.IDENT, "y"},
+		{token.STRING_TAIL, ""},
+		{token.RBRACE, "}"},
+		{token.STRING_TAIL, " c"},
+		{token.STRING_HEAD, ""},
+		{token.STRING_TAIL, ""},
+		{token.EOF, ""},
+	}
+
+	l := New(input)
+
+	for i, tt := range tests {
+		tok := l.NextToken()
+
+		if tok.Type != tt.expectedType {
+			t.Fatalf("tests[%d] - tokentype wrong. expected=%q, got=%q", i, tt.expectedType, tok.Type)
+		}
+
+		if tok.Literal != tt.expectedLiteral {
+			t.Fatalf("tests[%d] - literal wrong. expected=%q, got=%q", i, tt.expectedLiteral, tok.Literal)
+		}
+	}
+}
@@ -0,0 +1,202 @@
+package lexer
+
+import (
+	"devscript/src/token"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// An embedded expression ${...} of an interpolated string
+type interpolation struct {
+	// number of { opened inside the expression and not closed yet
+	braces int
+	// position of the opening quote of the string
+	quote token.Position
+}
+
+// reads a string literal, the current char is the opening quote.
+// Returns a STRING token with the escape sequences replaced,
+// or an ERROR token if the string is not closed before the end of the input
+// or has an invalid escape sequence.
+// Like raw strings, a string can span lines, the line breaks are part of the string
+// and Windows line endings are read as \n.
+// A string with embedded expressions starts with a STRING_HEAD token instead,
+// see readStringPart().
+//
+//	"a\"b"		// a"b
+//	"\u{1F600}"	// 😀
+//	"a
+//	b"		// a\nb
+func (lexer *Lexer) readString() token.Token {
+	return lexer.readStringPart(lexer.currentPosition(), token.STRING, token.STRING_HEAD)
+}
+
+// reads the text of a string up to the closing quote or the next ${,
+// the current char is the opening quote or the } closing the previous ${...}.
+// Returns a token of type end if the string is closed,
+// or of type open if an expression is embedded, the lexer then reads the
+// tokens of the expression until the matching }.
+//
+//	"Hello ${name}, you have ${count + 1} items"
+//	// STRING_HEAD "Hello ", IDENT name, STRING_MIDDLE ", you have ",
+//	// IDENT count, + , INT 1, STRING_TAIL " items"
+func (lexer *Lexer) readStringPart(quote token.Position, end, open token.TokenType) token.Token {
+	var out strings.Builder
+	// the first invalid escape sequence, reported once the string part is read
+	invalid := ""
+
+	newStringToken := func(tokenType token.TokenType) token.Token {
+		if invalid != "" {
+			return token.Token{Type: token.ERROR, Literal: invalid}
+		}
+		return token.Token{Type: tokenType, Literal: out.String()}
+	}
+
+	for {
+		lexer.readChar()
+
+		switch lexer.char {
+		case 0:
+			return token.Token{Type: token.ERROR, Literal: "unterminated string literal"}
+		case '"':
+			return newStringToken(end)
+		case '$':
+			if lexer.peekChar() != '{' {
+				out.WriteRune(lexer.char)
+				continue
+			}
+			lexer.readChar()
+			lexer.interpolations = append(lexer.interpolations, interpolation{quote: quote})
+			return newStringToken(open)
+		case '\\':
+			escaped, err := lexer.readEscape()
+			if err != "" && invalid == "" {
+				invalid = err
+			}
+			out.WriteString(escaped)
+		case '\r':
+			if !lexer.atCRLF() {
+				out.WriteRune(lexer.char)
+			}
+		default:
+			out.WriteRune(lexer.char)
+		}
+	}
+}
+
+// closes the innermost ${...}, if the current } is the end of the embedded expression.
+// Returns the position of the opening quote of the string.
+func (lexer *Lexer) closeInterpolation() (token.Position, bool) {
+	if len(lexer.interpolations) == 0 {
+		return token.Position{}, false
+	}
+
+	last := &lexer.interpolations[len(lexer.interpolations)-1]
+	if last.braces > 0 {
+		// closes a block or hash inside the expression
+		last.braces--
+		return token.Position{}, false
+	}
+
+	lexer.interpolations = lexer.interpolations[:len(lexer.interpolations)-1]
+	return last.quote, true
+}
+
+// Characters written after a backslash and the characters they stand for
+var escapes = map[rune]string{
+	'n':  "\n",
+	't':  "\t",
+	'r':  "\r",
+	'\\': "\\",
+	'"':  "\"",
+	'$':  "$",
+}
+
+// reads an escape sequence, the current char is the backslash.
+// Returns the escaped text, or an error message if the sequence is invalid.
+// The lexer stops at the last char of the sequence.
+//
+//	\n \t \r \\ \" \$ \u{1F600}
+func (lexer *Lexer) readEscape() (string, string) {
+	// leave the end of the input to readStringPart()
+	if lexer.peekChar() == 0 {
+		return "", ""
+	}
+	lexer.readChar()
+
+	if escaped, ok := escapes[lexer.char]; ok {
+		return escaped, ""
+	}
+	if lexer.char == '\n' || lexer.atCRLF() {
+		return "", "invalid escape sequence \\ at the end of a line"
+	}
+	if lexer.char == 'u' {
+		return lexer.readUnicodeEscape()
+	}
+
+	return "", "invalid escape sequence \\" + string(lexer.char)
+}
+
+// reads a \u{...} escape sequence, the current char is the u.
+// Takes 1 to 6 hexadecimal digits naming a Unicode code point.
+func (lexer *Lexer) readUnicodeEscape() (string, string) {
+	if lexer.peekChar() != '{' {
+		return "", "invalid escape sequence \\u, expected \\u{...}"
+	}
+	lexer.readChar()
+
+	start := lexer.position + 1
+	for isHexDigit(lexer.peekChar()) {
+		lexer.readChar()
+	}
+	digits := lexer.input[start : lexer.position+1]
+
+	if lexer.peekChar() != '}' {
+		return "", "invalid escape sequence \\u{" + digits + ", expected }"
+	}
+	lexer.readChar()
+
+	code, err := strconv.ParseUint(digits, 16, 32)
+	if err != nil || len(digits) > 6 || !utf8.ValidRune(rune(code)) {
+		return "", "invalid Unicode code point \\u{" + digits + "}"
+	}
+
+	return string(rune(code)), ""
+}
+
+// reads a raw string literal, the current char is the opening backtick.
+// Raw strings can span lines and have no escape sequences,
+// Windows line endings are read as \n so files saved on Windows give the same string.
+//
+//	`C:\path`	// C:\path
+func (lexer *Lexer) readRawString() token.Token {
+	var out strings.Builder
+
+	for {
+		lexer.readChar()
+
+		switch lexer.char {
+		case 0:
+			return token.Token{Type: token.ERROR, Literal: "unterminated raw string literal"}
+		case '`':
+			return token.Token{Type: token.STRING, Literal: out.String()}
+		case '\r':
+			if !lexer.atCRLF() {
+				out.WriteRune(lexer.char)
+			}
+		default:
+			out.WriteRune(lexer.char)
+		}
+	}
+}
+
+// returns true if the current char is the \r of a \r\n line ending,
+// the \n is read next
+func (lexer *Lexer) atCRLF() bool {
+	return lexer.char == '\r' && lexer.peekChar() == '\n'
+}
+
+func isHexDigit(ch rune) bool {
+	return isDigit(ch) || ('a' <= ch && ch <= 'f') || ('A' <= ch && ch <= 'F')
+}
@@ -0,0 +1,29 @@
+package object
+
+import (
+	"bytes"
+	"strings"
+)
+
+// Array is an ordered list of objects
+//
+//	[1, "two", true]
+type Array struct {
+	Elements []Object
+}
+
+func (a *Array) Type() ObjectType { return ARRAY_OBJ }
+func (a *Array) Inspect() string {
+	var out bytes.Buffer
+
+	elements := []string{}
+	for _, el := range a.Elements {
+		elements = append(elements, inspectElement(el))
+	}
+
+	out.WriteString("[")
+	out.WriteString(strings.Join(elements, ", "))
+	out.WriteString("]")
+
+	return out.String()
+}
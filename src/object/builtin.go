@@ -1,6 +1,8 @@
 package object
 
-type BuiltinFunction func(args ...Object) Object
+// BuiltinFunction is called with the Context of the caller,
+// to reach the streams of the interpreter
+type BuiltinFunction func(context *Context, args ...Object) Object
 
 type Builtin struct {
 	Function BuiltinFunction
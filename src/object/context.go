@@ -0,0 +1,29 @@
+package object
+
+import (
+	"bufio"
+	"io"
+	"os"
+)
+
+// Context is the state of a running interpreter,
+// shared by all the environments of a program
+type Context struct {
+	// streams the builtins read from and write to
+	Stdin  *bufio.Reader
+	Stdout io.Writer
+	Stderr io.Writer
+
+	// function calls being evaluated, the outermost call first
+	CallStack []Frame
+}
+
+// NewContext returns a Context reading from stdin and writing to stdout and stderr
+func NewContext(stdin io.Reader, stdout, stderr io.Writer) *Context {
+	return &Context{Stdin: bufio.NewReader(stdin), Stdout: stdout, Stderr: stderr}
+}
+
+// returns a Context using the streams of the process
+func newProcessContext() *Context {
+	return NewContext(os.Stdin, os.Stdout, os.Stderr)
+}
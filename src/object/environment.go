@@ -1,42 +1,158 @@
 package object
 
+import "fmt"
+
+// Keyword a name is declared with
+type Declaration int
+
+const (
+	// var x = 1;	function scoped, can be redeclared with var
+	VAR_DECLARATION Declaration = iota
+	// let x = 1;	block scoped
+	LET_DECLARATION
+	// const x = 1;	block scoped, cannot be assigned
+	CONST_DECLARATION
+)
+
+// a value stored in an environment together with how its name was declared
+type binding struct {
+	value       Object
+	declaration Declaration
+}
+
 // Environment is a map of string to Object
 //
 //	{
 //		"foo": Integer{Value: 1},
 //		"bar": Integer{Value: 2},
 //	}
+//
+// Every function call and every block gets its own environment,
+// var declarations go to the environment of the enclosing function.
 type Environment struct {
 	// store is a map of string to Object
-	store map[string]Object
+	store map[string]*binding
 	// points to the outer environment
 	outer *Environment
+	// true for the environment of a block, false for a function or the program
+	block bool
+	// state of the interpreter, shared with the outer environment
+	context *Context
 }
 
-// NewEnvironment returns a new Environment
+// NewEnvironment returns a new Environment using the streams of the process
 func NewEnvironment() *Environment {
-	store := make(map[string]Object)
-	return &Environment{store: store, outer: nil}
+	return NewEnvironmentWithContext(newProcessContext())
+}
+
+// NewEnvironmentWithContext returns a new Environment with the given Context
+func NewEnvironmentWithContext(context *Context) *Environment {
+	store := make(map[string]*binding)
+	return &Environment{store: store, outer: nil, context: context}
 }
 
-// returns a new Environment with the given store
+// returns a new Environment for a function call, enclosed by the environment
+// the function is defined in
 func NewEnclosedEnvironment(parentEnv *Environment) *Environment {
-	env := NewEnvironment()
+	if parentEnv == nil {
+		return NewEnvironment()
+	}
+
+	env := NewEnvironmentWithContext(parentEnv.context)
 	env.outer = parentEnv
 	return env
 }
 
+// returns a new Environment for a block, let and const declared
+// inside the block are not visible outside of it
+func NewBlockEnvironment(parentEnv *Environment) *Environment {
+	env := NewEnclosedEnvironment(parentEnv)
+	env.block = true
+	return env
+}
+
+// Context returns the state of the interpreter the environment belongs to
+func (env *Environment) Context() *Context {
+	return env.context
+}
+
 // Get returns the Object associated with the given name
 func (env *Environment) Get(name string) (Object, bool) {
-	obj, ok := env.store[name]
+	b, ok := env.store[name]
 	if !ok && env.outer != nil {
-		obj, ok = env.outer.Get(name)
+		return env.outer.Get(name)
 	}
-	return obj, ok
+	if !ok {
+		return nil, false
+	}
+	return b.value, true
 }
 
-// Set sets the Object associated with the given name
+// Set sets the Object associated with the given name in this environment,
+// used for names bound by the interpreter like function parameters
 func (env *Environment) Set(name string, val Object) Object {
-	env.store[name] = val
+	env.store[name] = &binding{value: val, declaration: VAR_DECLARATION}
 	return val
 }
+
+// Declare adds a name declared with var, let or const.
+// var declares the name in the enclosing function, let and const in this environment.
+//
+// Redeclaring a name in the same scope is an error, unless both declarations use var.
+// A var is also declared in the blocks between this environment and the function,
+// so it can't share a name with a let or const of those blocks.
+//
+//	var x = 1; var x = 2;		// ok
+//	let y = 1; let y = 2;		// y has already been declared
+//	{ let z = 1; var z = 2; }	// z has already been declared
+func (env *Environment) Declare(name string, val Object, declaration Declaration) error {
+	scope := env
+	if declaration == VAR_DECLARATION {
+		scope = env.functionScope()
+
+		for block := env; block != scope; block = block.outer {
+			if b, ok := block.store[name]; ok && b.declaration != VAR_DECLARATION {
+				return fmt.Errorf("identifier already declared: %s", name)
+			}
+		}
+	}
+
+	if b, ok := scope.store[name]; ok {
+		if b.declaration != VAR_DECLARATION || declaration != VAR_DECLARATION {
+			return fmt.Errorf("identifier already declared: %s", name)
+		}
+	}
+
+	scope.store[name] = &binding{value: val, declaration: declaration}
+	return nil
+}
+
+// Assign updates the value of a name in the environment it is declared in
+//
+//	var count = 0;
+//	var increment = func() { count = count + 1; };	// updates the outer count
+func (env *Environment) Assign(name string, val Object) error {
+	for scope := env; scope != nil; scope = scope.outer {
+		b, ok := scope.store[name]
+		if !ok {
+			continue
+		}
+
+		if b.declaration == CONST_DECLARATION {
+			return fmt.Errorf("cannot assign to constant: %s", name)
+		}
+		b.value = val
+		return nil
+	}
+
+	return fmt.Errorf("identifier not found: %s", name)
+}
+
+// returns the environment of the function or program enclosing this environment
+func (env *Environment) functionScope() *Environment {
+	scope := env
+	for scope.block && scope.outer != nil {
+		scope = scope.outer
+	}
+	return scope
+}
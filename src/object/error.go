@@ -1,8 +1,70 @@
 package object
 
+import "devscript/src/token"
+
+// Kinds of errors
+const (
+	// raised by the interpreter, eg. division by zero
+	RUNTIME_ERROR = "RuntimeError"
+	// raised by a throw statement
+	THROWN_ERROR = "Error"
+)
+
 type Error struct {
 	Message string
+	// RUNTIME_ERROR or THROWN_ERROR
+	Kind string
+	// value passed to throw, nil for errors raised by the interpreter
+	Value Object
+	// position of the token of the node the error was raised at
+	Pos token.Position
+	// position just after that token
+	End token.Position
+	// function calls being evaluated when the error was raised,
+	// the outermost call first
+	Stack []Frame
 }
 
 func (e *Error) Type() ObjectType { return ERROR_OBJ }
-func (e *Error) Inspect() string  { return "ERROR: " + e.Message }
+func (e *Error) Inspect() string {
+	if e.Pos.IsValid() {
+		return "ERROR: " + e.Pos.String() + ": " + e.Message
+	}
+	return "ERROR: " + e.Message
+}
+
+// Traceback returns the functions being evaluated when the error was raised,
+// each with the position it was evaluating, the outermost first.
+// The first frame is the code outside of any function,
+// the last one is at the position of the error.
+//
+//	<main> at the call of outer, outer at the call of inner, inner at the error
+func (e *Error) Traceback() []Frame {
+	frames := make([]Frame, 0, len(e.Stack)+1)
+
+	function := MAIN_FRAME
+	for _, call := range e.Stack {
+		frames = append(frames, Frame{Function: function, Pos: call.Pos})
+		function = call.Function
+	}
+
+	return append(frames, Frame{Function: function, Pos: e.Pos})
+}
+
+// Name of the frame of the code outside of any function
+const MAIN_FRAME = "<main>"
+
+// Frame is a function call on the call stack
+type Frame struct {
+	// name of the called function, "function" for function literals
+	Function string
+	// position of the call expression
+	Pos token.Position
+}
+
+// String returns the frame in the form
+//
+//	outer (main.ds:5:10)
+func (f Frame) String() string {
+	return f.Function + " (" + f.Pos.String() + ")"
+}
@@ -0,0 +1,14 @@
+package object
+
+// Exception is an error caught by a try statement,
+// bound to the name given in the catch clause
+//
+//	catch (e) { println(e.type, e.message); }
+type Exception struct {
+	Error *Error
+}
+
+func (e *Exception) Type() ObjectType { return EXCEPTION_OBJ }
+func (e *Exception) Inspect() string {
+	return e.Error.Kind + ": " + e.Error.Message
+}
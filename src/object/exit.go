@@ -0,0 +1,12 @@
+package object
+
+import "strconv"
+
+// Exit is returned by the exit builtin and stops the program with the exit code.
+// It unwinds the evaluation like an error, but can't be caught by a try statement.
+type Exit struct {
+	Code int
+}
+
+func (e *Exit) Type() ObjectType { return EXIT_OBJ }
+func (e *Exit) Inspect() string  { return "exit(" + strconv.Itoa(e.Code) + ")" }
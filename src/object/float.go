@@ -0,0 +1,26 @@
+package object
+
+import (
+	"strconv"
+	"strings"
+)
+
+type Float struct {
+	Value float64
+}
+
+// Inspect formats the float with the fewest digits needed,
+// whole numbers keep a trailing ".0" to tell them apart from integers
+//
+//	3.14, 2.0, 1e-09
+func (f *Float) Inspect() string {
+	s := strconv.FormatFloat(f.Value, 'g', -1, 64)
+	if !strings.ContainsAny(s, ".eIN") {
+		s += ".0"
+	}
+	return s
+}
+
+func (f *Float) Type() ObjectType {
+	return FLOAT_OBJ
+}
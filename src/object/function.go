@@ -1,7 +1,6 @@
 package object
 
 import (
-	"bytes"
 	"devscript/src/ast"
 	"strings"
 )
@@ -14,20 +13,21 @@ type Function struct {
 }
 
 func (f *Function) Type() ObjectType { return FUNCTION_OBJ }
-func (f *Function) Inspect() string {
-	var out bytes.Buffer
 
+// Inspect returns the signature of the function, the name is left out for anonymous functions
+//
+//	func add(x, y)
+//	func(x)
+func (f *Function) Inspect() string {
 	params := []string{}
 	for _, p := range f.Parameters {
 		params = append(params, p.String())
 	}
 
-	out.WriteString("func")
-	out.WriteString("(")
-	out.WriteString(strings.Join(params, ", "))
-	out.WriteString(") {\n")
-	out.WriteString(f.Body.String())
-	out.WriteString("\n}")
+	name := ""
+	if f.Name != nil {
+		name = " " + f.Name.Value
+	}
 
-	return out.String()
+	return "func" + name + "(" + strings.Join(params, ", ") + ")"
 }
@@ -0,0 +1,106 @@
+package object
+
+import (
+	"bytes"
+	"fmt"
+	"hash/fnv"
+	"strings"
+)
+
+// HashKey identifies a hashable object inside a Hash.
+// Objects with the same type and value have the same HashKey.
+type HashKey struct {
+	Type  ObjectType
+	Value uint64
+}
+
+// Hashable is implemented by objects that can be used as hash keys
+//
+//	Integer, String, Boolean
+type Hashable interface {
+	HashKey() HashKey
+}
+
+func (i *Integer) HashKey() HashKey {
+	return HashKey{Type: i.Type(), Value: uint64(i.Value)}
+}
+
+func (s *String) HashKey() HashKey {
+	h := fnv.New64a()
+	h.Write([]byte(s.Value))
+
+	return HashKey{Type: s.Type(), Value: h.Sum64()}
+}
+
+func (b *Boolean) HashKey() HashKey {
+	var value uint64
+
+	if b.Value {
+		value = 1
+	}
+
+	return HashKey{Type: b.Type(), Value: value}
+}
+
+// HashPair holds the original key object along with its value
+type HashPair struct {
+	Key   Object
+	Value Object
+}
+
+// Hash is a map of hashable keys to objects.
+// Pairs are kept in insertion order.
+//
+//	{"name": "x", 1: true}
+type Hash struct {
+	Pairs map[HashKey]HashPair
+	// insertion order of the keys
+	keys []HashKey
+}
+
+// NewHash returns an empty Hash
+func NewHash() *Hash {
+	return &Hash{Pairs: make(map[HashKey]HashPair)}
+}
+
+func (h *Hash) Type() ObjectType { return HASH_OBJ }
+func (h *Hash) Inspect() string {
+	var out bytes.Buffer
+
+	pairs := []string{}
+	for _, pair := range h.OrderedPairs() {
+		pairs = append(pairs, fmt.Sprintf("%s: %s", inspectElement(pair.Key), inspectElement(pair.Value)))
+	}
+
+	out.WriteString("{")
+	out.WriteString(strings.Join(pairs, ", "))
+	out.WriteString("}")
+
+	return out.String()
+}
+
+// Set adds or replaces the pair for the given key
+func (h *Hash) Set(key Hashable, pair HashPair) {
+	hashKey := key.HashKey()
+
+	if _, ok := h.Pairs[hashKey]; !ok {
+		h.keys = append(h.keys, hashKey)
+	}
+
+	h.Pairs[hashKey] = pair
+}
+
+// Get returns the pair for the given key
+func (h *Hash) Get(key Hashable) (HashPair, bool) {
+	pair, ok := h.Pairs[key.HashKey()]
+	return pair, ok
+}
+
+// OrderedPairs returns the pairs in insertion order
+func (h *Hash) OrderedPairs() []HashPair {
+	pairs := make([]HashPair, 0, len(h.keys))
+	for _, key := range h.keys {
+		pairs = append(pairs, h.Pairs[key])
+	}
+	return pairs
+}
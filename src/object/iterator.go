@@ -0,0 +1,88 @@
+package object
+
+import "unicode/utf8"
+
+// Iterator walks over the items of an Iterable, one item at a time
+type Iterator interface {
+	// Next returns the key and the value of the next item,
+	// ok is false once there are no items left
+	Next() (key, value Object, ok bool)
+}
+
+// Iterable is implemented by objects that can be looped over
+//
+//	String	// key: code point index, value: the code point as a String
+//	Array	// key: index, value: element
+//	Hash	// key: key, value: value (in insertion order)
+//	Range	// key: index, value: number
+type Iterable interface {
+	Iterator() Iterator
+}
+
+type stringIterator struct {
+	value  string
+	offset int
+	index  int64
+}
+
+// Iterator returns an iterator over the Unicode code points of the string
+func (s *String) Iterator() Iterator {
+	return &stringIterator{value: s.Value}
+}
+
+func (it *stringIterator) Next() (Object, Object, bool) {
+	if it.offset >= len(it.value) {
+		return nil, nil, false
+	}
+
+	r, size := utf8.DecodeRuneInString(it.value[it.offset:])
+	key := &Integer{Value: it.index}
+
+	it.offset += size
+	it.index++
+
+	return key, &String{Value: string(r)}, true
+}
+
+type arrayIterator struct {
+	elements []Object
+	index    int
+}
+
+// Iterator returns an iterator over the elements of the array
+func (a *Array) Iterator() Iterator {
+	return &arrayIterator{elements: a.Elements}
+}
+
+func (it *arrayIterator) Next() (Object, Object, bool) {
+	if it.index >= len(it.elements) {
+		return nil, nil, false
+	}
+
+	key := &Integer{Value: int64(it.index)}
+	value := it.elements[it.index]
+	it.index++
+
+	return key, value, true
+}
+
+type hashIterator struct {
+	pairs []HashPair
+	index int
+}
+
+// Iterator returns an iterator over the pairs of the hash in insertion order
+func (h *Hash) Iterator() Iterator {
+	return &hashIterator{pairs: h.OrderedPairs()}
+}
+
+func (it *hashIterator) Next() (Object, Object, bool) {
+	if it.index >= len(it.pairs) {
+		return nil, nil, false
+	}
+
+	pair := it.pairs[it.index]
+	it.index++
+
+	return pair.Key, pair.Value, true
+}
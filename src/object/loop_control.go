@@ -0,0 +1,15 @@
+package object
+
+// Break is returned by a break statement
+// and stops the innermost loop
+type Break struct{}
+
+func (b *Break) Type() ObjectType { return BREAK_OBJ }
+func (b *Break) Inspect() string  { return "break" }
+
+// Continue is returned by a continue statement
+// and skips to the next iteration of the innermost loop
+type Continue struct{}
+
+func (c *Continue) Type() ObjectType { return CONTINUE_OBJ }
+func (c *Continue) Inspect() string  { return "continue" }
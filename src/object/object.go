@@ -1,14 +1,24 @@
 package object
 
+import "strconv"
+
 const (
 	INTEGER_OBJ      = "INTEGER"
+	FLOAT_OBJ        = "FLOAT"
 	STRING_OBJ       = "STRING"
 	BOOLEAN_OBJ      = "BOOLEAN"
 	NULL_OBJ         = "NULL"
 	RETURN_VALUE_OBJ = "RETURN_VALUE"
 	ERROR_OBJ        = "ERROR"
+	BREAK_OBJ        = "BREAK"
+	CONTINUE_OBJ     = "CONTINUE"
 	FUNCTION_OBJ     = "FUNCTION"
 	BUILTIN_OBJ      = "BUILTIN"
+	ARRAY_OBJ        = "ARRAY"
+	HASH_OBJ         = "HASH"
+	RANGE_OBJ        = "RANGE"
+	EXCEPTION_OBJ    = "EXCEPTION"
+	EXIT_OBJ         = "EXIT"
 )
 
 type ObjectType string
@@ -17,3 +27,14 @@ type Object interface {
 	Type() ObjectType
 	Inspect() string
 }
+
+// returns the text of an element of an array or hash,
+// strings are quoted so they can be told apart from other values
+//
+//	["1", 1]	// not [1, 1]
+func inspectElement(obj Object) string {
+	if str, ok := obj.(*String); ok {
+		return strconv.Quote(str.Value)
+	}
+	return obj.Inspect()
+}
@@ -0,0 +1,56 @@
+package object
+
+import (
+	"fmt"
+	"math"
+)
+
+// Range is a lazy sequence of integers from Start (inclusive)
+// to End (exclusive), counting by Step.
+// The numbers are produced while iterating and never stored.
+//
+//	range(0, 10, 2)	// 0, 2, 4, 6, 8
+type Range struct {
+	Start int64
+	End   int64
+	Step  int64
+}
+
+func (r *Range) Type() ObjectType { return RANGE_OBJ }
+func (r *Range) Inspect() string {
+	return fmt.Sprintf("range(%d, %d, %d)", r.Start, r.End, r.Step)
+}
+
+type rangeIterator struct {
+	rng     *Range
+	current int64
+	index   int64
+	// true once current can't be advanced by the step without overflowing
+	done bool
+}
+
+// Iterator returns an iterator over the numbers of the range
+func (r *Range) Iterator() Iterator {
+	return &rangeIterator{rng: r, current: r.Start}
+}
+
+func (it *rangeIterator) Next() (Object, Object, bool) {
+	step := it.rng.Step
+	if it.done || (step > 0 && it.current >= it.rng.End) || (step < 0 && it.current <= it.rng.End) {
+		return nil, nil, false
+	}
+
+	key := &Integer{Value: it.index}
+	value := &Integer{Value: it.current}
+
+	// the next number would be past the largest or smallest integer,
+	// so it is past the end too
+	if (step > 0 && it.current > math.MaxInt64-step) || (step < 0 && it.current < math.MinInt64-step) {
+		it.done = true
+	} else {
+		it.current += step
+	}
+	it.index++
+
+	return key, value, true
+}
@@ -0,0 +1,68 @@
+package parser
+
+import (
+	"devscript/src/ast"
+	"devscript/src/token"
+)
+
+// Function to parse array literals
+//
+//	[1, 2 * 2, "three"];	// parseArrayLiteral
+func (parser *Parser) parseArrayLiteral() ast.Expression {
+	array := &ast.ArrayLiteral{Token: parser.curToken}
+
+	array.Elements = parser.parseExpressionList(token.RBRACKET)
+	array.RBracket = parser.curToken
+
+	return array
+}
+
+// Function to parse index expressions
+//
+//	myArray[1 + 1];	// parseIndexExpression
+func (parser *Parser) parseIndexExpression(left ast.Expression) ast.Expression {
+	exp := &ast.IndexExpression{Token: parser.curToken, Left: left}
+
+	// Advance past the LBRACKET token
+	parser.nextToken()
+
+	exp.Index = parser.parseExpression(LOWEST)
+
+	// Check if the next token is a RBRACKET token
+	if !parser.expectPeek(token.RBRACKET) {
+		return nil
+	}
+	exp.RBracket = parser.curToken
+
+	return exp
+}
+
+// Function to parse a comma separated list of expressions
+// until the end token is reached.
+//
+//	(1, 2, 3)	// call arguments, end = token.RPAREN
+//	[1, 2, 3]	// array elements, end = token.RBRACKET
+func (parser *Parser) parseExpressionList(end token.TokenType) []ast.Expression {
+	list := []ast.Expression{}
+
+	// Empty list
+	if parser.peekTokenIs(end) {
+		parser.nextToken()
+		return list
+	}
+
+	parser.nextToken()
+	list = append(list, parser.parseExpression(LOWEST))
+
+	for parser.peekTokenIs(token.COMMA) {
+		parser.nextToken()
+		parser.nextToken()
+		list = append(list, parser.parseExpression(LOWEST))
+	}
+
+	if !parser.expectPeek(end) {
+		return nil
+	}
+
+	return list
+}
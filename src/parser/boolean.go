@@ -16,3 +16,10 @@ func (parser *Parser) parseBoolean() ast.Expression {
 		Value: parser.curTokenIs(token.TRUE),
 	}
 }
+
+// Function to parse the null literal
+//
+//	null;
+func (parser *Parser) parseNull() ast.Expression {
+	return &ast.Null{Token: parser.curToken}
+}
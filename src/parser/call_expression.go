@@ -7,30 +7,7 @@ import (
 
 func (parser *Parser) parseCallExpression(function ast.Expression) ast.Expression {
 	exp := &ast.CallExpression{Token: parser.curToken, Function: function}
-	exp.Arguments = parser.parseCallArguments()
+	exp.Arguments = parser.parseExpressionList(token.RPAREN)
+	exp.RParen = parser.curToken
 	return exp
 }
-
-func (parser *Parser) parseCallArguments() []ast.Expression {
-	args := []ast.Expression{}
-
-	if parser.peekTokenIs(token.RPAREN) {
-		parser.nextToken()
-		return args
-	}
-
-	parser.nextToken()
-	args = append(args, parser.parseExpression(LOWEST))
-
-	for parser.peekTokenIs(token.COMMA) {
-		parser.nextToken()
-		parser.nextToken()
-		args = append(args, parser.parseExpression(LOWEST))
-	}
-
-	if !parser.expectPeek(token.RPAREN) {
-		return nil
-	}
-
-	return args
-}
@@ -0,0 +1,27 @@
+package parser
+
+import (
+	"devscript/src/ast"
+	"devscript/src/token"
+)
+
+// Function to parse ternary conditional expressions,
+// conditional expressions are right associative
+//
+//	x > 0 ? "positive" : "negative";	// parseConditionalExpression
+//	a ? b : c ? d : e;			// (a ? b : (c ? d : e))
+func (parser *Parser) parseConditionalExpression(condition ast.Expression) ast.Expression {
+	expression := &ast.ConditionalExpression{Token: parser.curToken, Condition: condition}
+
+	parser.nextToken()
+	expression.Consequence = parser.parseExpression(LOWEST)
+
+	if !parser.expectPeek(token.COLON) {
+		return nil
+	}
+
+	parser.nextToken()
+	expression.Alternative = parser.parseExpression(CONDITIONAL - 1)
+
+	return expression
+}
@@ -3,19 +3,8 @@ package parser
 import (
 	"devscript/src/ast"
 	"devscript/src/token"
-	"fmt"
 )
 
-// Function to return error if there is no prefix parse function for the current token
-//
-//	5;	// parseIntegerLiteral
-//	foo;	// parseIdentifier
-//	-5;	// parsePrefixExpression
-//	false;	// parseBoolean
-func (parser *Parser) noPrefixParseFnError(tokenType token.TokenType) {
-	fmt.Printf("No prefix parse function for %s found\n", tokenType)
-}
-
 // Function to parse the expressions
 //
 //	5; 		// parseIntegerLiteral
@@ -86,6 +75,11 @@ func (parser *Parser) parseInfixExpression(left ast.Expression) ast.Expression {
 	// Get the precedence of the current token
 	precedence := parser.curPrecedence()
 
+	// Right associative operators bind the right operand less tightly
+	if rightAssociative[parser.curToken.Type] {
+		precedence--
+	}
+
 	// Advance to the next token
 	parser.nextToken()
 
@@ -102,25 +96,51 @@ func (parser *Parser) parseIdentifier() ast.Expression {
 	return &ast.Identifier{Token: parser.curToken, Value: parser.curToken.Literal}
 }
 
-// Function to parse assignment expressions
+// Function to parse assignment expressions,
+// assignments are right associative
 //
-//	foo = 5;	// parseAssignmentExpression
+//	foo = 5;		// parseAssignmentExpression
+//	arr[0] += 1;		// parseAssignmentExpression
+//	a = b = 1;		// (a = (b = 1))
 func (parser *Parser) parseAssignmentExpression(left ast.Expression) ast.Expression {
+	parser.checkAssignmentTarget(left)
+
 	// Create a new AssignmentExpression struct instance, set the token to the current token
 	assignmentExpression := &ast.AssignmentExpression{
-		Token: parser.curToken,
-		Name:  left.(*ast.Identifier),
+		Token:    parser.curToken,
+		Operator: parser.curToken.Literal,
+		Target:   left,
 	}
 
 	// Advance to the next token
 	parser.nextToken()
 
-	// Initialize the Value field of the AssignmentExpression struct instance, with precedence of ASSIGNMENT
-	assignmentExpression.Value = parser.parseExpression(ASSIGN)
+	// Initialize the Value field of the AssignmentExpression struct instance,
+	// with a precedence lower than ASSIGN so a following assignment is part of the value
+	assignmentExpression.Value = parser.parseExpression(ASSIGN - 1)
 
 	return assignmentExpression
 }
 
+// Function to check that an expression can be assigned to,
+// adds an error and bails out of the statement if it can not
+//
+//	x = 1; arr[0] = 1; obj.x = 1;	// valid targets
+//	5 = x; f() = 1;			// cannot assign to 5, cannot assign to f()
+func (parser *Parser) checkAssignmentTarget(target ast.Expression) {
+	// the target failed to parse and the error is already reported
+	if target == nil {
+		panic(bailout{})
+	}
+
+	switch target.(type) {
+	case *ast.Identifier, *ast.IndexExpression, *ast.MemberExpression:
+		return
+	default:
+		parser.invalidAssignmentTargetError(target)
+	}
+}
+
 // Function to parse the string literals
 //
 //	"foobar"; "foo bar";
@@ -50,6 +50,7 @@ func TestParsingPrefixExpressions(t *testing.T) {
 	}{
 		{"!5;", "!", 5},
 		{"-15;", "-", 15},
+		{"~15;", "~", 15},
 	}
 
 	// Test each prefix expression
@@ -108,6 +109,21 @@ func TestParsingInfixExpressions(t *testing.T) {
 		{"true == true", true, "==", true},
 		{"true != false", true, "!=", false},
 		{"false == false", false, "==", false},
+
+		// After adding support for logical operators
+		{"true && false", true, "&&", false},
+		{"a || b", "a", "||", "b"},
+
+		// After adding support for comparison, arithmetic and bitwise operators
+		{"5 <= 5;", 5, "<=", 5},
+		{"5 >= 5;", 5, ">=", 5},
+		{"5 % 5;", 5, "%", 5},
+		{"5 ** 5;", 5, "**", 5},
+		{"5 & 5;", 5, "&", 5},
+		{"5 | 5;", 5, "|", 5},
+		{"5 ^ 5;", 5, "^", 5},
+		{"5 << 5;", 5, "<<", 5},
+		{"5 >> 5;", 5, ">>", 5},
 	}
 
 	// Test each infix expression
@@ -228,6 +244,11 @@ func TestParsingAssignmentExpressions(t *testing.T) {
 		{"x = 5;", "x", "=", 5},
 		{"y = 10;", "y", "=", 10},
 		{"foobar = y;", "foobar", "=", "y"},
+		{"x += 5;", "x", "+=", 5},
+		{"x -= y;", "x", "-=", "y"},
+		{"x *= 2;", "x", "*=", 2},
+		{"x /= 2;", "x", "/=", 2},
+		{"x %= 2;", "x", "%=", 2},
 	}
 
 	// Test each assignment expression
@@ -272,7 +293,7 @@ func testAssignmentExpression(testing *testing.T, expression ast.Expression, lef
 
 	// Check if the left value is correct
 
-	if !testLiteralExpression(testing, assignmentExpression.Name, left) {
+	if !testLiteralExpression(testing, assignmentExpression.Target, left) {
 		return false
 	}
 
@@ -290,6 +311,40 @@ func testAssignmentExpression(testing *testing.T, expression ast.Expression, lef
 	return true
 }
 
+func TestAssignmentTargets(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"arr[0] = 1;", "(arr[0]) = 1"},
+		{"obj.name = \"x\";", "(obj.name) = x"},
+		{"h[\"a\"].b += 2;", "((h[a]).b) += 2"},
+		// assignments are right associative
+		{"a = b = 1;", "a = b = 1"},
+		{"a = b += c * 2;", "a = b += (c * 2)"},
+	}
+
+	for _, tt := range tests {
+		lex := lexer.New(tt.input)
+		parser := New(lex)
+		program := parser.ParseProgram()
+		checkParserErrors(t, parser)
+
+		statement, ok := program.Statements[0].(*ast.ExpressionStatement)
+		if !ok {
+			t.Fatalf("program.Statements[0] is not ast.ExpressionStatement. got=%T", program.Statements[0])
+		}
+
+		if _, ok := statement.Expression.(*ast.AssignmentExpression); !ok {
+			t.Fatalf("expression is not ast.AssignmentExpression. got=%T", statement.Expression)
+		}
+
+		if statement.Expression.String() != tt.expected {
+			t.Errorf("expression.String() wrong. expected=%q, got=%q", tt.expected, statement.Expression.String())
+		}
+	}
+}
+
 // Function to test string literals
 func TestParsingStringLiteralExpression(t *testing.T) {
 	input := `"hello world";`
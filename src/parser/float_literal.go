@@ -0,0 +1,28 @@
+package parser
+
+import (
+	"devscript/src/ast"
+	"devscript/src/diagnostics"
+	"strconv"
+)
+
+// Function to parse the float literals
+//
+//	3.14;
+//	1e-9;
+func (parser *Parser) parseFloatLiteral() ast.Expression {
+	// Create a new FloatLiteral struct instance, set the token to the current token
+	floatLiteral := &ast.FloatLiteral{Token: parser.curToken}
+
+	// Convert the literal value from string to float64
+	value, err := strconv.ParseFloat(parser.curToken.Literal, 64)
+	if err != nil {
+		parser.errorAt(parser.curToken, diagnostics.InvalidNumber, "Could not parse %q as float", parser.curToken.Literal)
+		return nil
+	}
+
+	// Update the Value field of the FloatLiteral struct instance
+	floatLiteral.Value = value
+
+	return floatLiteral
+}
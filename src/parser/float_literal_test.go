@@ -0,0 +1,45 @@
+package parser
+
+import (
+	"devscript/src/ast"
+	"devscript/src/lexer"
+	"testing"
+)
+
+// Function to test the parsing of float literals
+func TestFloatLiteralExpression(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected float64
+	}{
+		{"3.14;", 3.14},
+		{"0.5;", 0.5},
+		{"1e-9;", 1e-9},
+		{"2.5E+3;", 2500},
+	}
+
+	for _, tt := range tests {
+		lex := lexer.New(tt.input)
+		parser := New(lex)
+		program := parser.ParseProgram()
+		checkParserErrors(t, parser)
+
+		if len(program.Statements) != 1 {
+			t.Fatalf("program.Statements does not contain 1 statements. got=%d", len(program.Statements))
+		}
+
+		statement, ok := program.Statements[0].(*ast.ExpressionStatement)
+		if !ok {
+			t.Fatalf("statement not *ast.ExpressionStatement. got=%T", program.Statements[0])
+		}
+
+		float, ok := statement.Expression.(*ast.FloatLiteral)
+		if !ok {
+			t.Fatalf("expression not *ast.FloatLiteral. got=%T", statement.Expression)
+		}
+
+		if float.Value != tt.expected {
+			t.Errorf("float.Value not %g. got=%g", tt.expected, float.Value)
+		}
+	}
+}
@@ -41,7 +41,7 @@ func (parser *Parser) parseFunctionExpression() ast.Expression {
 	}
 
 	// Parse the function body
-	functionExpression.Body = parser.parseBlockStatement()
+	functionExpression.Body = parser.parseFunctionBody()
 
 	return functionExpression
 }
@@ -67,11 +67,25 @@ func (parser *Parser) parseFunctionLiteral() ast.Expression {
 	}
 
 	// Parse the function body
-	functionLiteral.Body = parser.parseBlockStatement()
+	functionLiteral.Body = parser.parseFunctionBody()
 
 	return functionLiteral
 }
 
+// Function to parse the function body.
+//
+// Loops enclosing the function do not enclose its body,
+// so break and continue inside the body need a loop of their own.
+func (parser *Parser) parseFunctionBody() *ast.BlockStatement {
+	loopDepth := parser.loopDepth
+	parser.loopDepth = 0
+
+	body := parser.parseBlockStatement()
+
+	parser.loopDepth = loopDepth
+	return body
+}
+
 // Function to parse the function parameters
 //
 //	(x, y, z)	// parseFunctionParameters
@@ -0,0 +1,54 @@
+package parser
+
+import (
+	"devscript/src/ast"
+	"devscript/src/token"
+)
+
+// Function to parse hash literals
+//
+//	{"name": "x", 1: true};	// parseHashLiteral
+func (parser *Parser) parseHashLiteral() ast.Expression {
+	hash := &ast.HashLiteral{Token: parser.curToken}
+	hash.Pairs = []ast.HashPair{}
+
+	for !parser.peekTokenIs(token.RBRACE) {
+		parser.nextToken()
+		key := parser.parseExpression(LOWEST)
+
+		if !parser.expectPeek(token.COLON) {
+			return nil
+		}
+
+		parser.nextToken()
+		value := parser.parseExpression(LOWEST)
+
+		hash.Pairs = append(hash.Pairs, ast.HashPair{Key: key, Value: value})
+
+		// Pairs are separated by a COMMA, the last pair is followed by a RBRACE
+		if !parser.peekTokenIs(token.RBRACE) && !parser.expectPeek(token.COMMA) {
+			return nil
+		}
+	}
+
+	if !parser.expectPeek(token.RBRACE) {
+		return nil
+	}
+	hash.RBrace = parser.curToken
+
+	return hash
+}
+
+// Function to check if the LBRACE at the start of a statement
+// opens a hash literal instead of a block statement.
+//
+//	{}		// empty hash literal
+//	{"a": 1}	// hash literal, the first key is followed by a COLON
+//	{ x; }		// block statement
+func (parser *Parser) isHashLiteralStart() bool {
+	if parser.peekTokenIs(token.RBRACE) {
+		return true
+	}
+
+	return parser.peekAheadToken().Type == token.COLON
+}
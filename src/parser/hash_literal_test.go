@@ -0,0 +1,102 @@
+package parser
+
+import (
+	"devscript/src/ast"
+	"devscript/src/lexer"
+	"fmt"
+	"testing"
+)
+
+func TestParsingHashLiterals(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected map[string]int64
+	}{
+		{`{"one": 1, "two": 2, "three": 3}`, map[string]int64{"one": 1, "two": 2, "three": 3}},
+		{`{}`, map[string]int64{}},
+		{`var h = {"one": 1};`, map[string]int64{"one": 1}},
+	}
+
+	for _, tt := range tests {
+		lex := lexer.New(tt.input)
+		parser := New(lex)
+		program := parser.ParseProgram()
+		checkParserErrors(t, parser)
+
+		var exp ast.Expression
+		switch statement := program.Statements[0].(type) {
+		case *ast.ExpressionStatement:
+			exp = statement.Expression
+		case *ast.VarStatement:
+			exp = statement.Value
+		}
+
+		hash, ok := exp.(*ast.HashLiteral)
+		if !ok {
+			t.Fatalf("exp is not ast.HashLiteral. got=%T", exp)
+		}
+
+		if len(hash.Pairs) != len(tt.expected) {
+			t.Fatalf("hash.Pairs has wrong length. got=%d", len(hash.Pairs))
+		}
+
+		for _, pair := range hash.Pairs {
+			literal, ok := pair.Key.(*ast.StringLiteral)
+			if !ok {
+				t.Errorf("key is not ast.StringLiteral. got=%T", pair.Key)
+				continue
+			}
+
+			testIntegerLiteral(t, pair.Value, tt.expected[literal.String()])
+		}
+	}
+}
+
+func TestParsingHashLiteralsWithMixedKeys(t *testing.T) {
+	input := `{1: "one", true: "yes", "a" + "b": 2 * 3}`
+
+	lex := lexer.New(input)
+	parser := New(lex)
+	program := parser.ParseProgram()
+	checkParserErrors(t, parser)
+
+	statement := program.Statements[0].(*ast.ExpressionStatement)
+	hash, ok := statement.Expression.(*ast.HashLiteral)
+	if !ok {
+		t.Fatalf("exp is not ast.HashLiteral. got=%T", statement.Expression)
+	}
+
+	expected := `{1: one, true: yes, (a + b): (2 * 3)}`
+	if hash.String() != expected {
+		t.Errorf("hash.String() wrong. expected=%q, got=%q", expected, hash.String())
+	}
+}
+
+func TestHashLiteralOrBlockStatement(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{`{"a": 1}`, "*ast.ExpressionStatement"},
+		{`{x: 1}`, "*ast.ExpressionStatement"},
+		{`{}`, "*ast.ExpressionStatement"},
+		{`{ x; }`, "*ast.BlockStatement"},
+		{`{ var x = 1; x + 1; }`, "*ast.BlockStatement"},
+	}
+
+	for _, tt := range tests {
+		lex := lexer.New(tt.input)
+		parser := New(lex)
+		program := parser.ParseProgram()
+		checkParserErrors(t, parser)
+
+		if len(program.Statements) != 1 {
+			t.Fatalf("program.Statements does not contain 1 statement. got=%d", len(program.Statements))
+		}
+
+		got := fmt.Sprintf("%T", program.Statements[0])
+		if got != tt.expected {
+			t.Errorf("%q parsed as %s, want %s", tt.input, got, tt.expected)
+		}
+	}
+}
@@ -2,7 +2,7 @@ package parser
 
 import (
 	"devscript/src/ast"
-	"fmt"
+	"devscript/src/diagnostics"
 	"strconv"
 )
 
@@ -17,8 +17,7 @@ func (parser *Parser) parseIntegerLiteral() ast.Expression {
 	// Convert the literal value from string to int64
 	value, err := strconv.ParseInt(parser.curToken.Literal, 0, 64)
 	if err != nil {
-		msg := fmt.Sprintf("Could not parse %q as integer", parser.curToken.Literal)
-		parser.errors = append(parser.errors, msg)
+		parser.errorAt(parser.curToken, diagnostics.InvalidNumber, "Could not parse %q as integer", parser.curToken.Literal)
 		return nil
 	}
 
@@ -0,0 +1,34 @@
+package parser
+
+import (
+	"devscript/src/ast"
+	"devscript/src/token"
+)
+
+// Function to parse strings with embedded expressions,
+// the lexer splits the string into the text around the expressions
+//
+//	"Hello ${name}!";	// STRING_HEAD name STRING_TAIL
+func (parser *Parser) parseInterpolatedString() ast.Expression {
+	str := &ast.InterpolatedString{Token: parser.curToken}
+
+	for {
+		str.Parts = append(str.Parts, parser.parseStringLiteral())
+		if parser.curTokenIs(token.STRING_TAIL) {
+			return str
+		}
+
+		// the text after ${ ends the expression
+		if parser.peekTokenIs(token.STRING_MIDDLE) || parser.peekTokenIs(token.STRING_TAIL) {
+			parser.syntaxErrorAt(parser.peekToken, "expected an expression, got %s instead", token.RBRACE)
+		}
+
+		parser.nextToken()
+		str.Parts = append(str.Parts, parser.parseExpression(LOWEST))
+
+		if !parser.peekTokenIs(token.STRING_MIDDLE) && !parser.peekTokenIs(token.STRING_TAIL) {
+			parser.peekError(token.RBRACE)
+		}
+		parser.nextToken()
+	}
+}
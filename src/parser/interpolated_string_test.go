@@ -0,0 +1,77 @@
+package parser
+
+import (
+	"devscript/src/ast"
+	"devscript/src/lexer"
+	"testing"
+)
+
+func TestInterpolatedString(t *testing.T) {
+	tests := []struct {
+		input         string
+		expected      string
+		expectedParts int
+	}{
+		{`"Hello ${name}!"`, `"Hello ${name}!"`, 3},
+		{`"${a}${b}"`, `"${a}${b}"`, 5},
+		{`"you have ${count + 1} items"`, `"you have ${(count + 1)} items"`, 3},
+		{`"${user.name}: ${ {"a": 1}["a"] }"`, `"${(user.name)}: ${({a: 1}[a])}"`, 5},
+		{`"outer ${"inner ${x}"}"`, `"outer ${"inner ${x}"}"`, 3},
+	}
+
+	for _, tt := range tests {
+		lex := lexer.New(tt.input)
+		parser := New(lex)
+		program := parser.ParseProgram()
+		checkParserErrors(t, parser)
+
+		if len(program.Statements) != 1 {
+			t.Fatalf("program.Statements does not contain 1 statements. got=%d", len(program.Statements))
+		}
+
+		statement, ok := program.Statements[0].(*ast.ExpressionStatement)
+		if !ok {
+			t.Fatalf("program.Statements[0] is not ast.ExpressionStatement. got=%T", program.Statements[0])
+		}
+
+		str, ok := statement.Expression.(*ast.InterpolatedString)
+		if !ok {
+			t.Fatalf("expression is not ast.InterpolatedString. got=%T", statement.Expression)
+		}
+
+		if len(str.Parts) != tt.expectedParts {
+			t.Errorf("wrong number of parts. expected=%d, got=%d", tt.expectedParts, len(str.Parts))
+		}
+
+		if str.String() != tt.expected {
+			t.Errorf("str.String() wrong. expected=%q, got=%q", tt.expected, str.String())
+		}
+	}
+}
+
+func TestInterpolatedStringErrors(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{`var s = "${}";`, "1:12: expected an expression, got } instead"},
+		{`var s = "a ${x y} b";`, "1:16: expected next token to be }, got IDENT instead"},
+		{"var s = \"a ${x} b\nx;", "1:9: unterminated string literal"},
+		{`var s = "a ${x`, "1:15: expected next token to be }, got EOF instead"},
+	}
+
+	for _, tt := range tests {
+		lex := lexer.New(tt.input)
+		parser := New(lex)
+		parser.ParseProgram()
+
+		errors := parser.Errors()
+		if len(errors) == 0 {
+			t.Fatalf("expected parser errors for %q", tt.input)
+		}
+
+		if errors[0] != tt.expected {
+			t.Errorf("wrong error. expected=%q, got=%q", tt.expected, errors[0])
+		}
+	}
+}
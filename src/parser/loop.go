@@ -0,0 +1,197 @@
+package parser
+
+import (
+	"devscript/src/ast"
+	"devscript/src/token"
+)
+
+// Function to parse while loops
+//
+//	while (x < 10) { x = x + 1; }	// parseWhileStatement
+func (parser *Parser) parseWhileStatement() ast.Statement {
+	statement := &ast.WhileStatement{Token: parser.curToken}
+
+	// Check if the next token is a LPAREN token
+	if !parser.expectPeek(token.LPAREN) {
+		return nil
+	}
+
+	// Advance the current token to the next token
+	parser.nextToken()
+
+	// Parse the condition
+	statement.Condition = parser.parseExpression(LOWEST)
+
+	// Check if the next token is a RPAREN token
+	if !parser.expectPeek(token.RPAREN) {
+		return nil
+	}
+
+	// Check if the next token is a LBRACE token
+	if !parser.expectPeek(token.LBRACE) {
+		return nil
+	}
+
+	// Parse the loop body
+	statement.Body = parser.parseLoopBody()
+
+	return statement
+}
+
+// Function to parse C-style for loops.
+// Init, condition and post are optional.
+//
+//	for (var i = 0; i < 10; i = i + 1) { println(i); }	// parseForStatement
+//	for (;;) { break; }					// parseForStatement
+func (parser *Parser) parseForStatement() ast.Statement {
+	statement := &ast.ForStatement{Token: parser.curToken}
+
+	// Check if the next token is a LPAREN token
+	if !parser.expectPeek(token.LPAREN) {
+		return nil
+	}
+
+	// for (x in ...) or for (k, v in ...)
+	if parser.peekTokenIs(token.IDENT) {
+		switch parser.peekAheadToken().Type {
+		case token.IN, token.COMMA:
+			return parser.parseForInStatement(statement.Token)
+		}
+	}
+
+	// curToken: {Type: token.VAR, Literal: "var"} or {Type: token.SEMICOLON, Literal: ";"}
+	parser.nextToken()
+
+	// Parse the init statement, parseStatement() stops at the SEMICOLON
+	if !parser.curTokenIs(token.SEMICOLON) {
+		statement.Init = parser.parseStatement()
+
+		if !parser.curTokenIs(token.SEMICOLON) {
+			parser.peekError(token.SEMICOLON)
+			return nil
+		}
+	}
+
+	// Parse the condition
+	if !parser.peekTokenIs(token.SEMICOLON) {
+		parser.nextToken()
+		statement.Condition = parser.parseExpression(LOWEST)
+	}
+
+	if !parser.expectPeek(token.SEMICOLON) {
+		return nil
+	}
+
+	// Parse the post expression
+	if !parser.peekTokenIs(token.RPAREN) {
+		parser.nextToken()
+		statement.Post = parser.parseExpression(LOWEST)
+	}
+
+	if !parser.expectPeek(token.RPAREN) {
+		return nil
+	}
+
+	// Check if the next token is a LBRACE token
+	if !parser.expectPeek(token.LBRACE) {
+		return nil
+	}
+
+	// Parse the loop body
+	statement.Body = parser.parseLoopBody()
+
+	return statement
+}
+
+// Function to parse for-in loops,
+// called by parseForStatement() with the LPAREN as the current token.
+//
+//	for (x in [1, 2, 3]) { println(x); }		// parseForInStatement
+//	for (k, v in {"a": 1}) { println(k, v); }	// parseForInStatement
+func (parser *Parser) parseForInStatement(forToken token.Token) ast.Statement {
+	statement := &ast.ForInStatement{Token: forToken}
+
+	// curToken: {Type: token.IDENT, Literal: "x"}
+	parser.nextToken()
+	statement.Value = &ast.Identifier{Token: parser.curToken, Value: parser.curToken.Literal}
+
+	// Two loop variables, the first one is the key
+	if parser.peekTokenIs(token.COMMA) {
+		parser.nextToken()
+
+		if !parser.expectPeek(token.IDENT) {
+			return nil
+		}
+
+		statement.Key = statement.Value
+		statement.Value = &ast.Identifier{Token: parser.curToken, Value: parser.curToken.Literal}
+	}
+
+	if !parser.expectPeek(token.IN) {
+		return nil
+	}
+
+	// Parse the iterable expression
+	parser.nextToken()
+	statement.Iterable = parser.parseExpression(LOWEST)
+
+	if !parser.expectPeek(token.RPAREN) {
+		return nil
+	}
+
+	// Check if the next token is a LBRACE token
+	if !parser.expectPeek(token.LBRACE) {
+		return nil
+	}
+
+	// Parse the loop body
+	statement.Body = parser.parseLoopBody()
+
+	return statement
+}
+
+// Function to parse the body of a loop,
+// break and continue statements are allowed inside it.
+func (parser *Parser) parseLoopBody() *ast.BlockStatement {
+	parser.loopDepth++
+	body := parser.parseBlockStatement()
+	parser.loopDepth--
+
+	return body
+}
+
+// Function to parse break statements
+//
+//	break;	// parseBreakStatement
+func (parser *Parser) parseBreakStatement() ast.Statement {
+	statement := &ast.BreakStatement{Token: parser.curToken}
+
+	if parser.loopDepth == 0 {
+		parser.loopControlError()
+	}
+
+	// Check if the next token is a semicolon
+	if parser.peekTokenIs(token.SEMICOLON) {
+		parser.nextToken()
+	}
+
+	return statement
+}
+
+// Function to parse continue statements
+//
+//	continue;	// parseContinueStatement
+func (parser *Parser) parseContinueStatement() ast.Statement {
+	statement := &ast.ContinueStatement{Token: parser.curToken}
+
+	if parser.loopDepth == 0 {
+		parser.loopControlError()
+	}
+
+	// Check if the next token is a semicolon
+	if parser.peekTokenIs(token.SEMICOLON) {
+		parser.nextToken()
+	}
+
+	return statement
+}
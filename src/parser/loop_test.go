@@ -0,0 +1,168 @@
+package parser
+
+import (
+	"devscript/src/ast"
+	"devscript/src/lexer"
+	"testing"
+)
+
+func TestWhileStatement(t *testing.T) {
+	input := `while (x < 10) { x = x + 1; }`
+
+	lex := lexer.New(input)
+	parser := New(lex)
+	program := parser.ParseProgram()
+	checkParserErrors(t, parser)
+
+	if len(program.Statements) != 1 {
+		t.Fatalf("program.Statements does not contain 1 statements. got=%d", len(program.Statements))
+	}
+
+	statement, ok := program.Statements[0].(*ast.WhileStatement)
+	if !ok {
+		t.Fatalf("program.Statements[0] is not ast.WhileStatement. got=%T", program.Statements[0])
+	}
+
+	if !testInfixExpression(t, statement.Condition, "x", "<", 10) {
+		return
+	}
+
+	if len(statement.Body.Statements) != 1 {
+		t.Fatalf("body does not contain 1 statements. got=%d", len(statement.Body.Statements))
+	}
+}
+
+func TestForStatement(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{
+			"for (var i = 0; i < 10; i = i + 1) { println(i); }",
+			"for (var i = 0; (i < 10); i = (i + 1)) println(i)",
+		},
+		{
+			"for (i = 0; i < 10;) { i = i + 1; }",
+			"for (i = 0; (i < 10); ) i = (i + 1)",
+		},
+		{
+			"for (;;) { break; }",
+			"for (; ; ) break;",
+		},
+	}
+
+	for _, tt := range tests {
+		lex := lexer.New(tt.input)
+		parser := New(lex)
+		program := parser.ParseProgram()
+		checkParserErrors(t, parser)
+
+		if len(program.Statements) != 1 {
+			t.Fatalf("program.Statements does not contain 1 statements. got=%d", len(program.Statements))
+		}
+
+		statement, ok := program.Statements[0].(*ast.ForStatement)
+		if !ok {
+			t.Fatalf("program.Statements[0] is not ast.ForStatement. got=%T", program.Statements[0])
+		}
+
+		if statement.String() != tt.expected {
+			t.Errorf("statement.String() wrong. expected=%q, got=%q", tt.expected, statement.String())
+		}
+	}
+}
+
+func TestForInStatement(t *testing.T) {
+	tests := []struct {
+		input         string
+		expectedKey   string
+		expectedValue string
+		expected      string
+	}{
+		{
+			"for (x in [1, 2]) { println(x); }",
+			"", "x",
+			"for (x in [1, 2]) println(x)",
+		},
+		{
+			`for (k, v in {"a": 1}) { println(k, v); }`,
+			"k", "v",
+			"for (k, v in {a: 1}) println(k, v)",
+		},
+		{
+			"for (i in range(0, 10, 2)) { }",
+			"", "i",
+			"for (i in range(0, 10, 2)) ",
+		},
+	}
+
+	for _, tt := range tests {
+		lex := lexer.New(tt.input)
+		parser := New(lex)
+		program := parser.ParseProgram()
+		checkParserErrors(t, parser)
+
+		if len(program.Statements) != 1 {
+			t.Fatalf("program.Statements does not contain 1 statements. got=%d", len(program.Statements))
+		}
+
+		statement, ok := program.Statements[0].(*ast.ForInStatement)
+		if !ok {
+			t.Fatalf("program.Statements[0] is not ast.ForInStatement. got=%T", program.Statements[0])
+		}
+
+		if tt.expectedKey == "" && statement.Key != nil {
+			t.Errorf("statement.Key should be nil. got=%q", statement.Key)
+		}
+
+		if tt.expectedKey != "" {
+			testIdentifier(t, statement.Key, tt.expectedKey)
+		}
+
+		testIdentifier(t, statement.Value, tt.expectedValue)
+
+		if statement.String() != tt.expected {
+			t.Errorf("statement.String() wrong. expected=%q, got=%q", tt.expected, statement.String())
+		}
+	}
+}
+
+func TestBreakContinueInsideLoops(t *testing.T) {
+	input := `
+	while (true) {
+		if (x) { break; }
+		for (;;) { continue; }
+	}
+	`
+
+	lex := lexer.New(input)
+	parser := New(lex)
+	parser.ParseProgram()
+	checkParserErrors(t, parser)
+}
+
+func TestBreakContinueOutsideLoop(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"break;", "1:1: break statement outside of loop"},
+		{"if (true) { continue; }", "1:13: continue statement outside of loop"},
+		{"while (true) { func() { break; }; }", "1:25: break statement outside of loop"},
+	}
+
+	for _, tt := range tests {
+		lex := lexer.New(tt.input)
+		parser := New(lex)
+		parser.ParseProgram()
+
+		errors := parser.Errors()
+		if len(errors) != 1 {
+			t.Fatalf("expected 1 error for %q. got=%d (%v)", tt.input, len(errors), errors)
+		}
+
+		if errors[0] != tt.expected {
+			t.Errorf("wrong error. expected=%q, got=%q", tt.expected, errors[0])
+		}
+	}
+}
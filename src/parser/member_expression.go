@@ -0,0 +1,21 @@
+package parser
+
+import (
+	"devscript/src/ast"
+	"devscript/src/token"
+)
+
+// Function to parse member expressions
+//
+//	error.message;	// parseMemberExpression
+func (parser *Parser) parseMemberExpression(object ast.Expression) ast.Expression {
+	exp := &ast.MemberExpression{Token: parser.curToken, Object: object}
+
+	if !parser.expectPeek(token.IDENT) {
+		return nil
+	}
+
+	exp.Property = &ast.Identifier{Token: parser.curToken, Value: parser.curToken.Literal}
+
+	return exp
+}
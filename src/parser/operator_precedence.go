@@ -8,32 +8,84 @@ const (
 	LOWEST
 	// =
 	ASSIGN
+	// X ? Y : Z
+	CONDITIONAL
+	// ??
+	NULL_COALESCE
+	// ||
+	LOGICAL_OR
+	// &&
+	LOGICAL_AND
 	// == or !=
 	EQUALS
-	// < or >
+	// <, >, <= or >=
 	LESSGREATER
+	// |
+	BITWISE_OR
+	// ^
+	BITWISE_XOR
+	// &
+	BITWISE_AND
+	// << or >>
+	SHIFT
 	// + or -
 	SUM
-	// * or /
+	// *, / or %
 	PRODUCT
-	// -X or !X
+	// -X, !X or ~X
 	PREFIX
+	// X ** Y
+	POWER
+	// X++ or X--
+	POSTFIX
 	// myFunction(X)
 	CALL
+	// myArray[X] or myHash.X
+	INDEX
 )
 
 // Map of precedences
 var precedences = map[token.TokenType]int{
-	token.ASSIGN:   ASSIGN,
-	token.EQ:       EQUALS,
-	token.NOT_EQ:   EQUALS,
-	token.LT:       LESSGREATER,
-	token.GT:       LESSGREATER,
-	token.PLUS:     SUM,
-	token.MINUS:    SUM,
-	token.SLASH:    PRODUCT,
-	token.ASTERISK: PRODUCT,
-	token.LPAREN:   CALL,
+	token.ASSIGN:          ASSIGN,
+	token.PLUS_ASSIGN:     ASSIGN,
+	token.MINUS_ASSIGN:    ASSIGN,
+	token.ASTERISK_ASSIGN: ASSIGN,
+	token.SLASH_ASSIGN:    ASSIGN,
+	token.PERCENT_ASSIGN:  ASSIGN,
+	token.QUESTION:        CONDITIONAL,
+	token.NULL_COALESCE:   NULL_COALESCE,
+	token.OR:              LOGICAL_OR,
+	token.AND:             LOGICAL_AND,
+	token.EQ:              EQUALS,
+	token.NOT_EQ:          EQUALS,
+	token.LT:              LESSGREATER,
+	token.GT:              LESSGREATER,
+	token.LT_EQ:           LESSGREATER,
+	token.GT_EQ:           LESSGREATER,
+	token.BIT_OR:          BITWISE_OR,
+	token.BIT_XOR:         BITWISE_XOR,
+	token.BIT_AND:         BITWISE_AND,
+	token.SHIFT_LEFT:      SHIFT,
+	token.SHIFT_RIGHT:     SHIFT,
+	token.PLUS:            SUM,
+	token.MINUS:           SUM,
+	token.SLASH:           PRODUCT,
+	token.ASTERISK:        PRODUCT,
+	token.PERCENT:         PRODUCT,
+	token.POWER:           POWER,
+	token.INCREMENT:       POSTFIX,
+	token.DECREMENT:       POSTFIX,
+	token.LPAREN:          CALL,
+	token.LBRACKET:        INDEX,
+	token.DOT:             INDEX,
+}
+
+// Right associative operators,
+// the right operand is parsed with a lower precedence
+//
+//	2 ** 3 ** 2	// (2 ** (3 ** 2))
+var rightAssociative = map[token.TokenType]bool{
+	token.POWER: true,
 }
 
 // Peek the precedence of the next token
@@ -110,6 +110,121 @@ func TestOperatorPrecedenceParsing(t *testing.T) {
 			"add(a + b + c * d / f + g)",
 			"add((((a + b) + ((c * d) / f)) + g))",
 		},
+		// After adding index expressions
+		{
+			"a * [1, 2, 3, 4][b * c] * d",
+			"((a * ([1, 2, 3, 4][(b * c)])) * d)",
+		},
+		{
+			"add(a * b[2], b[1], 2 * [1, 2][1])",
+			"add((a * (b[2])), (b[1]), (2 * ([1, 2][1])))",
+		},
+		// After adding logical operators
+		{
+			"a || b && c",
+			"(a || (b && c))",
+		},
+		{
+			"a && b || c && d",
+			"((a && b) || (c && d))",
+		},
+		{
+			"x != 0 && 10 / x > 1",
+			"((x != 0) && ((10 / x) > 1))",
+		},
+		{
+			"a == b || !c",
+			"((a == b) || (!c))",
+		},
+		{
+			"x = a || b",
+			"x = (a || b)",
+		},
+		// After adding comparison, arithmetic and bitwise operators
+		{
+			"a <= b == c >= d",
+			"((a <= b) == (c >= d))",
+		},
+		{
+			"a + b % c",
+			"(a + (b % c))",
+		},
+		{
+			"a % b * c",
+			"((a % b) * c)",
+		},
+		{
+			"2 ** 3 ** 2",
+			"(2 ** (3 ** 2))",
+		},
+		{
+			"-2 ** 2",
+			"(-(2 ** 2))",
+		},
+		{
+			"2 ** -1",
+			"(2 ** (-1))",
+		},
+		{
+			"a * b ** c",
+			"(a * (b ** c))",
+		},
+		{
+			"a | b ^ c & d",
+			"(a | (b ^ (c & d)))",
+		},
+		{
+			"a & b == c",
+			"((a & b) == c)",
+		},
+		{
+			"1 << 2 + 3",
+			"(1 << (2 + 3))",
+		},
+		{
+			"a >> b < c << d",
+			"((a >> b) < (c << d))",
+		},
+		{
+			"~a & b",
+			"((~a) & b)",
+		},
+		{
+			"a | b && c",
+			"((a | b) && c)",
+		},
+		{
+			"a ?? b || c",
+			"(a ?? (b || c))",
+		},
+		{
+			"a ?? b ?? c",
+			"((a ?? b) ?? c)",
+		},
+		{
+			"a ?? b ? c : d",
+			"((a ?? b) ? c : d)",
+		},
+		{
+			"a < b ? a + 1 : b * 2",
+			"((a < b) ? (a + 1) : (b * 2))",
+		},
+		{
+			"a ? b : c ? d : e",
+			"(a ? b : (c ? d : e))",
+		},
+		{
+			"a ? b ? c : d : e",
+			"(a ? (b ? c : d) : e)",
+		},
+		{
+			"x = a ? b : c",
+			"x = (a ? b : c)",
+		},
+		{
+			"x == null ? null : x",
+			"((x == null) ? null : x)",
+		},
 	}
 
 	for _, tt := range tests {
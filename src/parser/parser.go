@@ -2,6 +2,7 @@ package parser
 
 import (
 	"devscript/src/ast"
+	"devscript/src/diagnostics"
 	"devscript/src/lexer"
 	"devscript/src/token"
 )
@@ -15,9 +16,19 @@ type Parser struct {
 	curToken token.Token
 	// Pointer to the next token
 	peekToken token.Token
+	// Tokens read ahead of the peekToken, see peekAheadToken()
+	lookahead []token.Token
 
 	// List of errors
-	errors []string
+	errors []*diagnostics.Diagnostic
+
+	// Number of LBRACE tokens not yet closed by a RBRACE token,
+	// used to find the end of a statement with a syntax error
+	braceDepth int
+
+	// Number of loops enclosing the current token,
+	// break and continue are only allowed inside a loop
+	loopDepth int
 
 	// Map of prefixParseFn functions
 	// Each function is associated with a token type
@@ -48,7 +59,7 @@ func New(lex *lexer.Lexer) *Parser {
 	// Create a new Parser struct instance
 	parser := &Parser{
 		lexer:  lex,
-		errors: []string{},
+		errors: []*diagnostics.Diagnostic{},
 	}
 
 	// 	After the first call to [nextToken()],
@@ -66,14 +77,22 @@ func New(lex *lexer.Lexer) *Parser {
 	// Register the prefixParseFn for the token type
 	parser.registerPrefix(token.IDENT, parser.parseIdentifier)
 	parser.registerPrefix(token.INT, parser.parseIntegerLiteral)
+	parser.registerPrefix(token.FLOAT, parser.parseFloatLiteral)
 	parser.registerPrefix(token.STRING, parser.parseStringLiteral)
+	parser.registerPrefix(token.STRING_HEAD, parser.parseInterpolatedString)
 	parser.registerPrefix(token.BANG, parser.parsePrefixExpression)
 	parser.registerPrefix(token.MINUS, parser.parsePrefixExpression)
+	parser.registerPrefix(token.BIT_NOT, parser.parsePrefixExpression)
+	parser.registerPrefix(token.INCREMENT, parser.parsePrefixUpdateExpression)
+	parser.registerPrefix(token.DECREMENT, parser.parsePrefixUpdateExpression)
 	parser.registerPrefix(token.TRUE, parser.parseBoolean)
 	parser.registerPrefix(token.FALSE, parser.parseBoolean)
+	parser.registerPrefix(token.NULL, parser.parseNull)
 	parser.registerPrefix(token.LPAREN, parser.parseGroupedExpression)
 	parser.registerPrefix(token.IF, parser.parseIfExpression)
 	parser.registerPrefix(token.FUNCTION, parser.parseFunctionExpression)
+	parser.registerPrefix(token.LBRACKET, parser.parseArrayLiteral)
+	parser.registerPrefix(token.LBRACE, parser.parseHashLiteral)
 
 	// Initialize the infixParseFns map
 	parser.infixParseFns = make(map[token.TokenType]infixParseFn)
@@ -86,8 +105,30 @@ func New(lex *lexer.Lexer) *Parser {
 	parser.registerInfix(token.NOT_EQ, parser.parseInfixExpression)
 	parser.registerInfix(token.LT, parser.parseInfixExpression)
 	parser.registerInfix(token.GT, parser.parseInfixExpression)
+	parser.registerInfix(token.LT_EQ, parser.parseInfixExpression)
+	parser.registerInfix(token.GT_EQ, parser.parseInfixExpression)
+	parser.registerInfix(token.PERCENT, parser.parseInfixExpression)
+	parser.registerInfix(token.POWER, parser.parseInfixExpression)
+	parser.registerInfix(token.BIT_AND, parser.parseInfixExpression)
+	parser.registerInfix(token.BIT_OR, parser.parseInfixExpression)
+	parser.registerInfix(token.BIT_XOR, parser.parseInfixExpression)
+	parser.registerInfix(token.SHIFT_LEFT, parser.parseInfixExpression)
+	parser.registerInfix(token.SHIFT_RIGHT, parser.parseInfixExpression)
+	parser.registerInfix(token.AND, parser.parseInfixExpression)
+	parser.registerInfix(token.OR, parser.parseInfixExpression)
+	parser.registerInfix(token.NULL_COALESCE, parser.parseInfixExpression)
+	parser.registerInfix(token.QUESTION, parser.parseConditionalExpression)
 	parser.registerInfix(token.LPAREN, parser.parseCallExpression)
+	parser.registerInfix(token.LBRACKET, parser.parseIndexExpression)
+	parser.registerInfix(token.DOT, parser.parseMemberExpression)
 	parser.registerInfix(token.ASSIGN, parser.parseAssignmentExpression)
+	parser.registerInfix(token.PLUS_ASSIGN, parser.parseAssignmentExpression)
+	parser.registerInfix(token.MINUS_ASSIGN, parser.parseAssignmentExpression)
+	parser.registerInfix(token.ASTERISK_ASSIGN, parser.parseAssignmentExpression)
+	parser.registerInfix(token.SLASH_ASSIGN, parser.parseAssignmentExpression)
+	parser.registerInfix(token.PERCENT_ASSIGN, parser.parseAssignmentExpression)
+	parser.registerInfix(token.INCREMENT, parser.parsePostfixUpdateExpression)
+	parser.registerInfix(token.DECREMENT, parser.parsePostfixUpdateExpression)
 
 	return parser
 }
@@ -108,9 +149,37 @@ func (parser *Parser) registerInfix(tokenType token.TokenType, fn infixParseFn)
 // Sets the currentToken to the peekToken and updates the peekToken to the next token from the lexer.
 func (parser *Parser) nextToken() {
 	parser.curToken = parser.peekToken
+
+	switch parser.curToken.Type {
+	case token.LBRACE:
+		parser.braceDepth++
+	case token.RBRACE:
+		if parser.braceDepth > 0 {
+			parser.braceDepth--
+		}
+	}
+
+	// Use the token already read by peekAheadToken() if there is one
+	if len(parser.lookahead) > 0 {
+		parser.peekToken = parser.lookahead[0]
+		parser.lookahead = parser.lookahead[1:]
+		return
+	}
+
 	parser.peekToken = parser.lexer.NextToken()
 }
 
+// Function to get the token after the peekToken without advancing the parser.
+//
+//	{ "a": 1 }
+//	curToken: {, peekToken: "a", peekAheadToken(): :
+func (parser *Parser) peekAheadToken() token.Token {
+	if len(parser.lookahead) == 0 {
+		parser.lookahead = append(parser.lookahead, parser.lexer.NextToken())
+	}
+	return parser.lookahead[0]
+}
+
 // Function to parse the program
 //
 // Returns an ast.Program
@@ -121,16 +190,13 @@ func (parser *Parser) ParseProgram() *ast.Program {
 	program.Statements = []ast.Statement{}
 
 	for parser.curToken.Type != token.EOF {
-		// Parse each statement
-		statement := parser.parseStatement()
+		// Parse each statement, skipping the statements with syntax errors
+		statement := parser.parseStatementWithRecovery()
 
 		if statement != nil {
 			// Add the statement to the list of statements
 			program.Statements = append(program.Statements, statement)
 		}
-
-		// Get the next token
-		parser.nextToken()
 	}
 	return program
 }
@@ -1,17 +1,94 @@
 package parser
 
 import (
+	"devscript/src/ast"
+	"devscript/src/diagnostics"
 	"devscript/src/token"
 	"fmt"
 )
 
-// Returns the list of errors
+// Returns the list of errors, one line per error
+//
+//	main.ds:3:14: expected next token to be ), got ; instead
 func (parser *Parser) Errors() []string {
+	errors := []string{}
+	for _, d := range parser.errors {
+		errors = append(errors, d.Error())
+	}
+	return errors
+}
+
+// Returns the list of errors as diagnostics,
+// to be rendered with the source code they point into
+func (parser *Parser) Diagnostics() []*diagnostics.Diagnostic {
 	return parser.errors
 }
 
+// Function adds an error for the token to the list of errors
+func (parser *Parser) errorAt(tok token.Token, code string, format string, a ...interface{}) *diagnostics.Diagnostic {
+	d := diagnostics.New(code, diagnostics.TokenSpan(tok), format, a...)
+	parser.errors = append(parser.errors, d)
+	return d
+}
+
+// Function adds a syntax error for the token to the list of errors
+// and bails out of the statement being parsed, see parseStatementWithRecovery()
+func (parser *Parser) syntaxErrorAt(tok token.Token, format string, a ...interface{}) {
+	parser.errorAt(tok, diagnostics.UnexpectedToken, format, a...)
+	panic(bailout{})
+}
+
 // Function adds a peekError to the list of errors, if the next token is not of the expected type
 func (parser *Parser) peekError(nextToken token.TokenType) {
-	msg := fmt.Sprintf("expected next token to be %s, got %s instead", nextToken, parser.peekToken.Type)
-	parser.errors = append(parser.errors, msg)
+	if parser.peekTokenIs(token.ERROR) {
+		parser.lexerError(parser.peekToken)
+	}
+	parser.syntaxErrorAt(parser.peekToken,
+		"expected next token to be %s, got %s instead", nextToken, parser.peekToken.Type)
+}
+
+// Function adds an error to the list of errors, if there is no prefix parse function for the current token
+//
+//	var x = ;	// expected an expression, got ; instead
+func (parser *Parser) noPrefixParseFnError(tokenType token.TokenType) {
+	if tokenType == token.ERROR {
+		parser.lexerError(parser.curToken)
+	}
+	parser.syntaxErrorAt(parser.curToken, "expected an expression, got %s instead", tokenType)
+}
+
+// Function adds the error of a malformed token to the list of errors,
+// the lexer stores the message as the literal of the token
+//
+//	var s = "abc;	// unterminated string literal
+func (parser *Parser) lexerError(tok token.Token) {
+	parser.errorAt(tok, diagnostics.InvalidString, "%s", tok.Literal)
+	panic(bailout{})
+}
+
+// Function adds an error to the list of errors, if the input ends before the block is closed
+func (parser *Parser) unterminatedBlockError(block token.Token) {
+	parser.errorAt(parser.curToken, diagnostics.UnexpectedToken,
+		"expected %s, got %s instead", token.RBRACE, parser.curToken.Type).
+		WithHint(fmt.Sprintf("the block opened at %s is never closed", block.Pos))
+	panic(bailout{})
+}
+
+// Function adds an error to the list of errors, if an expression that is not a variable,
+// an index or a member expression is assigned to
+//
+//	5 = x;	// cannot assign to 5
+func (parser *Parser) invalidAssignmentTargetError(target ast.Expression) {
+	span := diagnostics.Span{Start: target.Pos(), End: target.End()}
+	d := diagnostics.New(diagnostics.UnexpectedToken, span, "cannot assign to %s", target.String()).
+		WithHint("only variables, index expressions and properties can be assigned to")
+	parser.errors = append(parser.errors, d)
+	panic(bailout{})
+}
+
+// Function adds an error to the list of errors, if break or continue is used outside of a loop
+func (parser *Parser) loopControlError() {
+	parser.errorAt(parser.curToken, diagnostics.LoopControlOutsideLoop,
+		"%s statement outside of loop", parser.curToken.Literal).
+		WithHint(parser.curToken.Literal + " can only be used inside a while or for loop")
 }
@@ -1,6 +1,10 @@
 package parser
 
-import "testing"
+import (
+	"devscript/src/ast"
+	"devscript/src/lexer"
+	"testing"
+)
 
 /*
 Check if there are any errors in the parser,
@@ -26,3 +30,138 @@ func checkParserErrors(testing *testing.T, parser *Parser) {
 	// Fail the test
 	testing.FailNow()
 }
+
+func TestParserErrorPositions(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"var = 5;", "main.ds:1:5: expected next token to be IDENT, got = instead"},
+		{"var x = 5;\nif (x { x }", "main.ds:2:7: expected next token to be ), got { instead"},
+		{"\n\n  break;", "main.ds:3:3: break statement outside of loop"},
+		{"var s = \"abc;\nx;", "main.ds:1:9: unterminated string literal"},
+		{"var s = \"a\nb\";\nvar t = \"c", "main.ds:3:9: unterminated string literal"},
+		{"print(\"a\\qb\");", "main.ds:1:7: invalid escape sequence \\q"},
+		{"var s = `abc", "main.ds:1:9: unterminated raw string literal"},
+		{"const x;", "main.ds:1:8: expected next token to be =, got ; instead"},
+		{"5 = x;", "main.ds:1:1: cannot assign to 5"},
+		{"x = 1;\nf() += 1;", "main.ds:2:1: cannot assign to f()"},
+		{"++5;", "main.ds:1:3: cannot assign to 5"},
+		{"x++++;", "main.ds:1:1: cannot assign to (x++)"},
+		// the target fails to parse, only its error is reported
+		{"99999999999999999999 = 1;", "main.ds:1:1: Could not parse \"99999999999999999999\" as integer"},
+		{"99999999999999999999 += 1;", "main.ds:1:1: Could not parse \"99999999999999999999\" as integer"},
+		{"++99999999999999999999;", "main.ds:1:3: Could not parse \"99999999999999999999\" as integer"},
+		{"99999999999999999999++;", "main.ds:1:1: Could not parse \"99999999999999999999\" as integer"},
+		{"var y = a ? b;", "main.ds:1:14: expected next token to be :, got ; instead"},
+	}
+
+	for _, tt := range tests {
+		lex := lexer.NewWithFile(tt.input, "main.ds")
+		parser := New(lex)
+		parser.ParseProgram()
+
+		errors := parser.Errors()
+		if len(errors) == 0 {
+			t.Fatalf("expected parser errors for %q", tt.input)
+		}
+
+		if errors[0] != tt.expected {
+			t.Errorf("wrong error. expected=%q, got=%q", tt.expected, errors[0])
+		}
+	}
+}
+
+func TestParserReportsEveryError(t *testing.T) {
+	input := `var x = ;
+var y = 5;
+if (y { y }
+var z = 1 +;
+func f(a, b) {
+	var q = * 2;
+	return a + b;
+}
+var h = {"a": };
+y;
+`
+	expected := []string{
+		"main.ds:1:9: expected an expression, got ; instead",
+		"main.ds:3:7: expected next token to be ), got { instead",
+		"main.ds:4:12: expected an expression, got ; instead",
+		"main.ds:6:10: expected an expression, got * instead",
+		"main.ds:9:15: expected an expression, got } instead",
+	}
+
+	lex := lexer.NewWithFile(input, "main.ds")
+	parser := New(lex)
+	program := parser.ParseProgram()
+
+	errors := parser.Errors()
+	if len(errors) != len(expected) {
+		t.Fatalf("wrong number of errors. expected=%d, got=%d (%q)", len(expected), len(errors), errors)
+	}
+
+	for i, msg := range expected {
+		if errors[i] != msg {
+			t.Errorf("wrong error %d. expected=%q, got=%q", i, msg, errors[i])
+		}
+	}
+
+	// var y, func f and y are parsed, the statements with errors are skipped
+	if len(program.Statements) != 3 {
+		t.Fatalf("program.Statements does not contain 3 statements. got=%d", len(program.Statements))
+	}
+
+	function, ok := program.Statements[1].(*ast.ExpressionStatement).Expression.(*ast.FunctionExpression)
+	if !ok {
+		t.Fatalf("program.Statements[1] is not a function. got=%s", program.Statements[1])
+	}
+
+	if len(function.Body.Statements) != 1 {
+		t.Errorf("function body does not contain 1 statement. got=%d", len(function.Body.Statements))
+	}
+}
+
+func TestParserUnterminatedBlock(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"func f() {\n  var x = 1;\n", "main.ds:3:1: expected }, got EOF instead"},
+		{"while (true) {", "main.ds:1:15: expected }, got EOF instead"},
+		{"{ x; ", "main.ds:1:6: expected }, got EOF instead"},
+	}
+
+	for _, tt := range tests {
+		lex := lexer.NewWithFile(tt.input, "main.ds")
+		parser := New(lex)
+		parser.ParseProgram()
+
+		errors := parser.Errors()
+		if len(errors) != 1 {
+			t.Fatalf("expected 1 parser error for %q, got=%q", tt.input, errors)
+		}
+
+		if errors[0] != tt.expected {
+			t.Errorf("wrong error. expected=%q, got=%q", tt.expected, errors[0])
+		}
+	}
+}
+
+func TestParserErrorInsideBlock(t *testing.T) {
+	input := "if (true) { x + } else { y }; z;"
+
+	lex := lexer.NewWithFile(input, "main.ds")
+	parser := New(lex)
+	program := parser.ParseProgram()
+
+	errors := parser.Errors()
+	expected := "main.ds:1:17: expected an expression, got } instead"
+	if len(errors) != 1 || errors[0] != expected {
+		t.Fatalf("expected error %q, got=%q", expected, errors)
+	}
+
+	if len(program.Statements) != 2 {
+		t.Fatalf("program.Statements does not contain 2 statements. got=%d", len(program.Statements))
+	}
+}
@@ -0,0 +1,82 @@
+package parser
+
+import (
+	"devscript/src/ast"
+	"devscript/src/token"
+)
+
+// bailout is raised by a syntax error, it unwinds the parser
+// to the statement that is being parsed
+type bailout struct{}
+
+// Statements a synchronizing parser can restart from
+var statementKeywords = map[token.TokenType]bool{
+	token.VAR:      true,
+	token.LET:      true,
+	token.CONST:    true,
+	token.RETURN:   true,
+	token.IF:       true,
+	token.FUNCTION: true,
+	token.WHILE:    true,
+	token.FOR:      true,
+	token.BREAK:    true,
+	token.CONTINUE: true,
+	token.THROW:    true,
+	token.TRY:      true,
+}
+
+// Function to parse a statement and advance to the first token of the next statement.
+//
+// On a syntax error the statement is dropped and the parser synchronizes:
+// the tokens up to the end of the statement are skipped,
+// so a single mistake does not cause a cascade of errors.
+//
+//	var x = ;	// error, skipped up to the SEMICOLON
+//	var y = 5;	// parsed
+func (parser *Parser) parseStatementWithRecovery() (statement ast.Statement) {
+	depth := parser.braceDepth
+
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(bailout); !ok {
+				panic(r)
+			}
+
+			parser.synchronize(depth)
+			statement = nil
+		}
+	}()
+
+	statement = parser.parseStatement()
+	parser.nextToken()
+
+	return statement
+}
+
+// Function to skip the tokens of a statement with a syntax error.
+// depth is the braceDepth the statement started at.
+//
+// Stops after a SEMICOLON or a RBRACE closing the braces opened by the statement,
+// before a RBRACE closing the enclosing block, or before a statement keyword.
+//
+//	if (x { x } 	// skips up to and including the RBRACE
+//	{ y + }		// stops at the RBRACE closing the block
+func (parser *Parser) synchronize(depth int) {
+	for !parser.curTokenIs(token.EOF) {
+		// the current token closes the enclosing block
+		if parser.braceDepth < depth {
+			return
+		}
+
+		if parser.braceDepth == depth && (parser.curTokenIs(token.SEMICOLON) || parser.curTokenIs(token.RBRACE)) {
+			parser.nextToken()
+			return
+		}
+
+		parser.nextToken()
+
+		if parser.braceDepth == depth && statementKeywords[parser.curToken.Type] {
+			return
+		}
+	}
+}
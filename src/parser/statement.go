@@ -11,6 +11,13 @@ import (
 //	parseStatement() calls:
 //		parseVarStatement() 	// variable statements
 //		parseReturnStatement() 	// return statements
+//		parseBlockStatement() 	// block statements
+//		parseWhileStatement() 	// while loops
+//		parseForStatement() 	// for loops
+//		parseBreakStatement() 	// break statements
+//		parseContinueStatement() 	// continue statements
+//		parseThrowStatement() 	// throw statements
+//		parseTryStatement() 	// try statements
 //		parseExpressionStatement() 	// expression statements
 func (parser *Parser) parseStatement() ast.Statement {
 
@@ -18,13 +25,42 @@ func (parser *Parser) parseStatement() ast.Statement {
 	// This requires parsing of each statement to be different.
 	switch parser.curToken.Type {
 	// Parse variable statements
-	case token.VAR:
+	case token.VAR, token.LET, token.CONST:
 		return parser.parseVarStatement()
 
 	// Parse return statements
 	case token.RETURN:
 		return parser.parseReturnStatement()
 
+	// Parse loops
+	case token.WHILE:
+		return parser.parseWhileStatement()
+	case token.FOR:
+		return parser.parseForStatement()
+
+	// Parse exception handling statements
+	case token.THROW:
+		return parser.parseThrowStatement()
+	case token.TRY:
+		return parser.parseTryStatement()
+
+	// Parse loop control statements
+	case token.BREAK:
+		return parser.parseBreakStatement()
+	case token.CONTINUE:
+		return parser.parseContinueStatement()
+
+	// An empty statement
+	case token.SEMICOLON:
+		return nil
+
+	// Parse block statements, a LBRACE can also start a hash literal
+	case token.LBRACE:
+		if parser.isHashLiteralStart() {
+			return parser.parseExpressionStatement()
+		}
+		return parser.parseBlockStatement()
+
 	// Parse expression statements (default)
 	default:
 		return parser.parseExpressionStatement()
@@ -33,9 +69,12 @@ func (parser *Parser) parseStatement() ast.Statement {
 
 // Function parses the variable statements.
 //
-// Variable statements are statements that start with the keyword "var".
+// Variable statements are statements that start with the keyword "var", "let" or "const".
+// A const must be given a value.
 //
 //	var x = 5;
+//	let y;		// y is 0
+//	const z = 10;
 func (parser *Parser) parseVarStatement() *ast.VarStatement {
 
 	// Create a new VarStatement struct instance, set the token to the current token
@@ -58,6 +97,9 @@ func (parser *Parser) parseVarStatement() *ast.VarStatement {
 	// curToken: {Type: token.IDENT, Literal: "x"}
 	// peekToken: {Type: token.ASSIGN, Literal: "="}
 	if !parser.peekTokenIs(token.ASSIGN) {
+		if statement.Token.Type == token.CONST {
+			parser.peekError(token.ASSIGN)
+		}
 
 		// if the next token is a semicolon,
 		// then the variable statement is a declaration statement
@@ -170,12 +212,16 @@ func (parser *Parser) parseBlockStatement() *ast.BlockStatement {
 
 	// Parse the statements until the next token is a RBRACE token
 	for !parser.curTokenIs(token.RBRACE) {
-		statement := parser.parseStatement()
+		if parser.curTokenIs(token.EOF) {
+			parser.unterminatedBlockError(block.Token)
+		}
+
+		statement := parser.parseStatementWithRecovery()
 		if statement != nil {
 			block.Statements = append(block.Statements, statement)
 		}
-		parser.nextToken()
 	}
+	block.RBrace = parser.curToken
 
 	return block
 }
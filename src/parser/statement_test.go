@@ -42,6 +42,37 @@ func TestVarStatement(testing *testing.T) {
 	}
 }
 
+func TestLetAndConstStatements(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"let x = 5;", "let x = 5;"},
+		{"let x;", "let x = 0;"},
+		{"const limit = 10 * 2;", "const limit = (10 * 2);"},
+	}
+
+	for _, tt := range tests {
+		lex := lexer.New(tt.input)
+		parser := New(lex)
+		program := parser.ParseProgram()
+		checkParserErrors(t, parser)
+
+		if len(program.Statements) != 1 {
+			t.Fatalf("program.Statements does not contain 1 statements. got=%d", len(program.Statements))
+		}
+
+		statement, ok := program.Statements[0].(*ast.VarStatement)
+		if !ok {
+			t.Fatalf("program.Statements[0] is not ast.VarStatement. got=%T", program.Statements[0])
+		}
+
+		if statement.String() != tt.expected {
+			t.Errorf("statement.String() wrong. expected=%q, got=%q", tt.expected, statement.String())
+		}
+	}
+}
+
 // Test the variable statement
 //
 // Tests:
@@ -0,0 +1,74 @@
+package parser
+
+import (
+	"devscript/src/ast"
+	"devscript/src/token"
+)
+
+// Function to parse throw statements
+//
+//	throw "invalid input";	// parseThrowStatement
+func (parser *Parser) parseThrowStatement() ast.Statement {
+	statement := &ast.ThrowStatement{Token: parser.curToken}
+
+	// Parse the thrown expression
+	parser.nextToken()
+	statement.Value = parser.parseExpression(LOWEST)
+
+	if parser.peekTokenIs(token.SEMICOLON) {
+		parser.nextToken()
+	}
+
+	return statement
+}
+
+// Function to parse try statements
+//
+//	try { risky(); } catch (e) { println(e.message); }	// parseTryStatement
+//	try { risky(); } finally { cleanup(); }		// parseTryStatement
+func (parser *Parser) parseTryStatement() ast.Statement {
+	statement := &ast.TryStatement{Token: parser.curToken}
+
+	if !parser.expectPeek(token.LBRACE) {
+		return nil
+	}
+	statement.Block = parser.parseBlockStatement()
+
+	// catch (e) { ... }
+	if parser.peekTokenIs(token.CATCH) {
+		parser.nextToken()
+
+		if !parser.expectPeek(token.LPAREN) {
+			return nil
+		}
+		if !parser.expectPeek(token.IDENT) {
+			return nil
+		}
+		statement.Param = &ast.Identifier{Token: parser.curToken, Value: parser.curToken.Literal}
+
+		if !parser.expectPeek(token.RPAREN) {
+			return nil
+		}
+		if !parser.expectPeek(token.LBRACE) {
+			return nil
+		}
+		statement.Catch = parser.parseBlockStatement()
+	}
+
+	// finally { ... }
+	if parser.peekTokenIs(token.FINALLY) {
+		parser.nextToken()
+
+		if !parser.expectPeek(token.LBRACE) {
+			return nil
+		}
+		statement.Finally = parser.parseBlockStatement()
+	}
+
+	if statement.Catch == nil && statement.Finally == nil {
+		parser.syntaxErrorAt(parser.peekToken,
+			"expected next token to be %s or %s, got %s instead", token.CATCH, token.FINALLY, parser.peekToken.Type)
+	}
+
+	return statement
+}
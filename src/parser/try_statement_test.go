@@ -0,0 +1,118 @@
+package parser
+
+import (
+	"devscript/src/ast"
+	"devscript/src/lexer"
+	"testing"
+)
+
+func TestThrowStatement(t *testing.T) {
+	input := `throw "invalid " + name;`
+
+	lex := lexer.New(input)
+	parser := New(lex)
+	program := parser.ParseProgram()
+	checkParserErrors(t, parser)
+
+	if len(program.Statements) != 1 {
+		t.Fatalf("program.Statements does not contain 1 statements. got=%d", len(program.Statements))
+	}
+
+	statement, ok := program.Statements[0].(*ast.ThrowStatement)
+	if !ok {
+		t.Fatalf("program.Statements[0] is not ast.ThrowStatement. got=%T", program.Statements[0])
+	}
+
+	if statement.String() != "throw (invalid  + name);" {
+		t.Errorf("statement.String() wrong. got=%q", statement.String())
+	}
+}
+
+func TestTryStatement(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{
+			"try { risky(); } catch (e) { println(e.message); }",
+			"try risky() catch (e) println((e.message))",
+		},
+		{
+			"try { risky(); } finally { cleanup(); }",
+			"try risky() finally cleanup()",
+		},
+		{
+			"try { risky(); } catch (err) { throw err; } finally { cleanup(); }",
+			"try risky() catch (err) throw err; finally cleanup()",
+		},
+	}
+
+	for _, tt := range tests {
+		lex := lexer.New(tt.input)
+		parser := New(lex)
+		program := parser.ParseProgram()
+		checkParserErrors(t, parser)
+
+		if len(program.Statements) != 1 {
+			t.Fatalf("program.Statements does not contain 1 statements. got=%d", len(program.Statements))
+		}
+
+		statement, ok := program.Statements[0].(*ast.TryStatement)
+		if !ok {
+			t.Fatalf("program.Statements[0] is not ast.TryStatement. got=%T", program.Statements[0])
+		}
+
+		if statement.String() != tt.expected {
+			t.Errorf("statement.String() wrong. expected=%q, got=%q", tt.expected, statement.String())
+		}
+	}
+}
+
+func TestTryStatementErrors(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"try { x; }", "1:11: expected next token to be CATCH or FINALLY, got EOF instead"},
+		{"try { x; } catch { y; }", "1:18: expected next token to be (, got { instead"},
+		{"try { x; } catch (1) { y; }", "1:19: expected next token to be IDENT, got INT instead"},
+	}
+
+	for _, tt := range tests {
+		lex := lexer.New(tt.input)
+		parser := New(lex)
+		parser.ParseProgram()
+
+		errors := parser.Errors()
+		if len(errors) != 1 {
+			t.Fatalf("expected 1 parser error for %q, got=%q", tt.input, errors)
+		}
+
+		if errors[0] != tt.expected {
+			t.Errorf("wrong error. expected=%q, got=%q", tt.expected, errors[0])
+		}
+	}
+}
+
+func TestMemberExpression(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"e.message", "(e.message)"},
+		{"e.value.code + 1", "(((e.value).code) + 1)"},
+		{"a.list[0].name", "(((a.list)[0]).name)"},
+		{"f().x", "(f().x)"},
+	}
+
+	for _, tt := range tests {
+		lex := lexer.New(tt.input)
+		parser := New(lex)
+		program := parser.ParseProgram()
+		checkParserErrors(t, parser)
+
+		if program.String() != tt.expected {
+			t.Errorf("expected=%q, got=%q", tt.expected, program.String())
+		}
+	}
+}
@@ -0,0 +1,39 @@
+package parser
+
+import (
+	"devscript/src/ast"
+)
+
+// Function to parse increments and decrements written before the target,
+// the expression evaluates to the updated value
+//
+//	++x;		// parsePrefixUpdateExpression
+//	--arr[0];	// parsePrefixUpdateExpression
+func (parser *Parser) parsePrefixUpdateExpression() ast.Expression {
+	expression := &ast.UpdateExpression{
+		Token:    parser.curToken,
+		Operator: parser.curToken.Literal,
+		Prefix:   true,
+	}
+
+	parser.nextToken()
+	expression.Target = parser.parseExpression(PREFIX)
+	parser.checkAssignmentTarget(expression.Target)
+
+	return expression
+}
+
+// Function to parse increments and decrements written after the target,
+// the expression evaluates to the value before the update
+//
+//	x++;		// parsePostfixUpdateExpression
+//	obj.count--;	// parsePostfixUpdateExpression
+func (parser *Parser) parsePostfixUpdateExpression(left ast.Expression) ast.Expression {
+	parser.checkAssignmentTarget(left)
+
+	return &ast.UpdateExpression{
+		Token:    parser.curToken,
+		Operator: parser.curToken.Literal,
+		Target:   left,
+	}
+}
@@ -0,0 +1,52 @@
+package parser
+
+import (
+	"devscript/src/ast"
+	"devscript/src/lexer"
+	"testing"
+)
+
+func TestUpdateExpression(t *testing.T) {
+	tests := []struct {
+		input    string
+		operator string
+		prefix   bool
+		expected string
+	}{
+		{"++x;", "++", true, "(++x)"},
+		{"--x;", "--", true, "(--x)"},
+		{"x++;", "++", false, "(x++)"},
+		{"x--;", "--", false, "(x--)"},
+		{"arr[i]++;", "++", false, "((arr[i])++)"},
+		{"++obj.count;", "++", true, "(++(obj.count))"},
+		{"-x++;", "-", false, "(-(x++))"},
+		{"x++ + ++y;", "+", false, "((x++) + (++y))"},
+	}
+
+	for _, tt := range tests {
+		lex := lexer.New(tt.input)
+		parser := New(lex)
+		program := parser.ParseProgram()
+		checkParserErrors(t, parser)
+
+		if len(program.Statements) != 1 {
+			t.Fatalf("program.Statements does not contain 1 statements. got=%d", len(program.Statements))
+		}
+
+		statement, ok := program.Statements[0].(*ast.ExpressionStatement)
+		if !ok {
+			t.Fatalf("program.Statements[0] is not ast.ExpressionStatement. got=%T", program.Statements[0])
+		}
+
+		if update, ok := statement.Expression.(*ast.UpdateExpression); ok {
+			if update.Operator != tt.operator || update.Prefix != tt.prefix {
+				t.Errorf("wrong update expression. expected=%s (prefix=%t), got=%s (prefix=%t)",
+					tt.operator, tt.prefix, update.Operator, update.Prefix)
+			}
+		}
+
+		if statement.Expression.String() != tt.expected {
+			t.Errorf("expression.String() wrong. expected=%q, got=%q", tt.expected, statement.Expression.String())
+		}
+	}
+}
@@ -1,51 +1,117 @@
 package repl
 
 import (
-	"bufio"
+	"devscript/src/ast"
+	"devscript/src/diagnostics"
 	"devscript/src/eval"
 	"devscript/src/lexer"
 	"devscript/src/object"
 	"devscript/src/parser"
 	"fmt"
 	"io"
+	"strings"
 )
 
 const PROMPT = ">> "
 
-func Start(in io.Reader, out io.Writer) {
-	scanner := bufio.NewScanner(in)
+// Starts the REPL, returns the exit code passed to exit(),
+// or 0 when the input ends.
+//
+// Programs read from in and write to out, including eprint and eprintln,
+// so input() reads the lines following the one being evaluated
+func Start(in io.Reader, out io.Writer) int {
+	ctx := object.NewContext(in, out, out)
 	// New environment
-	env := object.NewEnvironment()
+	env := object.NewEnvironmentWithContext(ctx)
+	eval.SetArgs(env, nil)
 
 	for {
-		fmt.Print(PROMPT)
-		scanned := scanner.Scan()
-		if !scanned {
-			return
+		io.WriteString(out, PROMPT)
+		line, err := ctx.Stdin.ReadString('\n')
+		if err != nil && line == "" {
+			return 0
 		}
 
-		line := scanner.Text()
+		line = strings.TrimRight(line, "\r\n")
 		lex := lexer.New(line)
 		parser := parser.New(lex)
 
 		program := parser.ParseProgram()
 		if len(parser.Errors()) != 0 {
-			printParseErrors(out, parser.Errors())
+			printParseErrors(out, line, parser.Diagnostics())
 			continue
 		}
 
-		evaluatedResult := eval.Eval(program, env)
-		if evaluatedResult != nil {
+		evaluatedResult := safeEval(program, env)
+		switch result := evaluatedResult.(type) {
+		case *object.Error:
+			printRuntimeError(out, line, result)
+			continue
+		case *object.Exit:
+			return result.Code
+		}
+
+		if evaluatedResult != nil && !printsOnly(program, env) {
 			io.WriteString(out, evaluatedResult.Inspect())
 			io.WriteString(out, "\n")
 		}
 	}
 }
 
-func printParseErrors(out io.Writer, errors []string) {
-	io.WriteString(out, "Oh you found out an error!!! \n")
-	io.WriteString(out, "Parser errors: \n")
-	for _, msg := range errors {
-		io.WriteString(out, "\t"+msg+"\n")
+// Builtins called for their output, their null result is not echoed
+var printBuiltins = map[string]bool{
+	"print":    true,
+	"println":  true,
+	"eprint":   true,
+	"eprintln": true,
+	"printf":   true,
+}
+
+// Returns true if the last statement of the program is a call to a print builtin,
+// unless the name has been redefined
+//
+//	println("hi");	// hi
+//	null;		// null
+func printsOnly(program *ast.Program, env *object.Environment) bool {
+	if len(program.Statements) == 0 {
+		return false
+	}
+
+	statement, ok := program.Statements[len(program.Statements)-1].(*ast.ExpressionStatement)
+	if !ok {
+		return false
+	}
+	call, ok := statement.Expression.(*ast.CallExpression)
+	if !ok {
+		return false
+	}
+	function, ok := call.Function.(*ast.Identifier)
+	if !ok || !printBuiltins[function.Value] {
+		return false
 	}
+
+	_, redefined := env.Get(function.Value)
+	return !redefined
+}
+
+// Evaluates the program and recovers from Go panics inside the interpreter,
+// so a bug in the interpreter is reported as an error instead of ending the session
+func safeEval(program *ast.Program, env *object.Environment) (result object.Object) {
+	defer func() {
+		if r := recover(); r != nil {
+			result = &object.Error{Message: fmt.Sprintf("internal error: %v", r)}
+		}
+	}()
+
+	return eval.Eval(program, env)
+}
+
+// Prints the parser errors with the line they were found in
+func printParseErrors(out io.Writer, source string, errors []*diagnostics.Diagnostic) {
+	diagnostics.NewRendererFor(out, source).RenderAll(out, errors)
+}
+
+// Prints a runtime error with its traceback and the line it was raised in
+func printRuntimeError(out io.Writer, source string, err *object.Error) {
+	diagnostics.NewRendererFor(out, source).RenderTraceback(out, err)
 }
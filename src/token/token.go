@@ -1,14 +1,24 @@
 package token
 
+import "fmt"
+
 const (
 	ILLEGAL = "ILLEGAL"
 	EOF     = "EOF"
+	// malformed token, the literal is the error message
+	ERROR = "ERROR"
 
 	// Identifiers + literals
 	IDENT  = "IDENT"
 	INT    = "INT"
+	FLOAT  = "FLOAT"
 	STRING = "STRING"
 
+	// Parts of an interpolated string, "a ${x} b ${y} c"
+	STRING_HEAD   = "STRING_HEAD"   // "a ${
+	STRING_MIDDLE = "STRING_MIDDLE" // } b ${
+	STRING_TAIL   = "STRING_TAIL"   // } c"
+
 	// Operators
 	ASSIGN   = "="
 	PLUS     = "+"
@@ -16,30 +26,75 @@ const (
 	BANG     = "!"
 	ASTERISK = "*"
 	SLASH    = "/"
+	PERCENT  = "%"
+	POWER    = "**"
+
+	// Compound assignment operators
+	PLUS_ASSIGN     = "+="
+	MINUS_ASSIGN    = "-="
+	ASTERISK_ASSIGN = "*="
+	SLASH_ASSIGN    = "/="
+	PERCENT_ASSIGN  = "%="
+
+	INCREMENT = "++"
+	DECREMENT = "--"
+
+	LT    = "<"
+	GT    = ">"
+	LT_EQ = "<="
+	GT_EQ = ">="
 
-	LT = "<"
-	GT = ">"
+	// Bitwise operators
+	BIT_AND     = "&"
+	BIT_OR      = "|"
+	BIT_XOR     = "^"
+	BIT_NOT     = "~"
+	SHIFT_LEFT  = "<<"
+	SHIFT_RIGHT = ">>"
 
 	EQ     = "=="
 	NOT_EQ = "!="
 
+	AND = "&&"
+	OR  = "||"
+
+	// Conditional operators
+	QUESTION      = "?"
+	NULL_COALESCE = "??"
+
 	// Delimiters
 	COMMA     = ","
 	SEMICOLON = ";"
+	COLON     = ":"
+	DOT       = "."
 
-	LPAREN = "("
-	RPAREN = ")"
-	LBRACE = "{"
-	RBRACE = "}"
+	LPAREN   = "("
+	RPAREN   = ")"
+	LBRACE   = "{"
+	RBRACE   = "}"
+	LBRACKET = "["
+	RBRACKET = "]"
 
 	// Keywords
 	FUNCTION = "FUNCTION"
 	VAR      = "VAR"
+	LET      = "LET"
+	CONST    = "CONST"
 	TRUE     = "TRUE"
 	FALSE    = "FALSE"
+	NULL     = "NULL"
 	IF       = "IF"
 	ELSE     = "ELSE"
 	RETURN   = "RETURN"
+	WHILE    = "WHILE"
+	FOR      = "FOR"
+	IN       = "IN"
+	BREAK    = "BREAK"
+	CONTINUE = "CONTINUE"
+	THROW    = "THROW"
+	TRY      = "TRY"
+	CATCH    = "CATCH"
+	FINALLY  = "FINALLY"
 )
 
 type TokenType string
@@ -47,16 +102,62 @@ type TokenType string
 type Token struct {
 	Type    TokenType
 	Literal string
+	// position of the first character of the token
+	Pos Position
+	// position just after the last character of the token
+	End Position
+}
+
+// Position is a location in the source code.
+// Line and Column start at 1, File is empty for input not read from a file.
+type Position struct {
+	File   string
+	Line   int
+	Column int
+}
+
+// IsValid returns true if the position points into the source code
+func (pos Position) IsValid() bool {
+	return pos.Line > 0
+}
+
+// String returns the position in the form
+//
+//	file:line:column
+//	line:column	// without a file
+func (pos Position) String() string {
+	if !pos.IsValid() {
+		return ""
+	}
+
+	s := fmt.Sprintf("%d:%d", pos.Line, pos.Column)
+	if pos.File != "" {
+		s = pos.File + ":" + s
+	}
+
+	return s
 }
 
 var keywords = map[string]TokenType{
-	"func":   FUNCTION,
-	"var":    VAR,
-	"true":   TRUE,
-	"false":  FALSE,
-	"if":     IF,
-	"else":   ELSE,
-	"return": RETURN,
+	"func":     FUNCTION,
+	"var":      VAR,
+	"let":      LET,
+	"const":    CONST,
+	"true":     TRUE,
+	"false":    FALSE,
+	"null":     NULL,
+	"if":       IF,
+	"else":     ELSE,
+	"return":   RETURN,
+	"while":    WHILE,
+	"for":      FOR,
+	"in":       IN,
+	"break":    BREAK,
+	"continue": CONTINUE,
+	"throw":    THROW,
+	"try":      TRY,
+	"catch":    CATCH,
+	"finally":  FINALLY,
 }
 
 /*